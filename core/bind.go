@@ -0,0 +1,201 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"github.com/appellative-ai/common/fmtx"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	BindPath   = "path"
+	BindQuery  = "query"
+	BindHeader = "header"
+	BindForm   = "form"
+
+	bindRequired = "required"
+)
+
+var (
+	bindSources  = []string{BindPath, BindQuery, BindHeader, BindForm}
+	bytesType    = reflect.TypeOf([]byte(nil))
+	durationType = reflect.TypeOf(time.Duration(0))
+	timeType     = reflect.TypeOf(time.Time{})
+)
+
+// Bind - fill the exported fields of a struct from the request path values, query parameters, headers and
+// form fields, using the path, query, header and form struct tags. A tag is a name, optionally followed by
+// ",required", as in `query:"limit,required"`. A field with more than one tag is bound from the first source,
+// in path, query, header and form order, that has a value.
+//
+// Fields can be strings, []byte, integers, floats, bools, time.Duration, time.Time (RFC 3339), or slices of these
+// types. Each repeated query parameter or form field is an element, and header values are also split on commas,
+// as in an RFC 9110 list. Missing values that are not required are left unchanged. The bound values are returned
+// as arguments, and all binding errors are returned as a *Status with StatusInvalidArgument.
+func Bind(r *http.Request, v any) ([]Arg, error) {
+	if r == nil {
+		return nil, NewStatus(StatusInvalidArgument, errors.New("request is nil"))
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return nil, NewStatus(StatusInvalidArgument, errors.New(fmt.Sprintf("bind type: %v is not a pointer to a struct", reflect.TypeOf(v))))
+	}
+	rv = rv.Elem()
+	rt := rv.Type()
+	b := &binder{r: r}
+	var args []Arg
+	var errs []error
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		var missing error
+		bound := false
+		for _, source := range bindSources {
+			tag, ok := field.Tag.Lookup(source)
+			if !ok {
+				continue
+			}
+			name, option, _ := strings.Cut(tag, ",")
+			if name == "" {
+				name = field.Name
+			}
+			values, err := b.values(source, name)
+			if err != nil {
+				errs = append(errs, err)
+				bound = true
+				break
+			}
+			if len(values) == 0 {
+				if option == bindRequired && missing == nil {
+					missing = errors.New(fmt.Sprintf("%v %v is required", source, name))
+				}
+				continue
+			}
+			bound = true
+			if err = setField(rv.Field(i), values, source == BindHeader); err != nil {
+				errs = append(errs, errors.New(fmt.Sprintf("%v %v is invalid: %v", source, name, err)))
+				break
+			}
+			args = append(args, Arg{Name: name, Value: rv.Field(i).Interface()})
+			break
+		}
+		if !bound && missing != nil {
+			errs = append(errs, missing)
+		}
+	}
+	if len(errs) > 0 {
+		return args, NewStatus(StatusInvalidArgument, errors.Join(errs...))
+	}
+	return args, nil
+}
+
+// binder - request values, the query is parsed once for each Bind call
+type binder struct {
+	r     *http.Request
+	query url.Values
+}
+
+func (b *binder) values(source, name string) ([]string, error) {
+	switch source {
+	case BindPath:
+		if s := b.r.PathValue(name); s != "" {
+			return []string{s}, nil
+		}
+	case BindQuery:
+		if b.query == nil && b.r.URL != nil {
+			b.query = b.r.URL.Query()
+		}
+		return b.query[name], nil
+	case BindHeader:
+		return b.r.Header.Values(name), nil
+	case BindForm:
+		if err := b.r.ParseForm(); err != nil {
+			return nil, errors.New(fmt.Sprintf("form is invalid: %v", err))
+		}
+		return b.r.PostForm[name], nil
+	}
+	return nil, nil
+}
+
+// setField - a []byte is set from the first value, and a list value is split on commas
+func setField(v reflect.Value, values []string, list bool) error {
+	if v.Type() == bytesType {
+		v.SetBytes([]byte(values[0]))
+		return nil
+	}
+	if v.Kind() != reflect.Slice {
+		return setValue(v, values[0])
+	}
+	if list {
+		var split []string
+		for _, s := range values {
+			split = append(split, strings.Split(s, ",")...)
+		}
+		values = split
+	}
+	slice := reflect.MakeSlice(v.Type(), len(values), len(values))
+	for i, s := range values {
+		if err := setValue(slice.Index(i), strings.TrimSpace(s)); err != nil {
+			return err
+		}
+	}
+	v.Set(slice)
+	return nil
+}
+
+func setValue(v reflect.Value, s string) error {
+	switch v.Type() {
+	case durationType:
+		d, err := fmtx.ParseDuration(s)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+		return nil
+	case timeType:
+		t, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(t))
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	default:
+		return errors.New(fmt.Sprintf("type %v is not supported", v.Type()))
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package core
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+)
+
+type searchArgs struct {
+	Id      int           `path:"id,required"`
+	Query   string        `query:"q"`
+	Limit   int           `query:"limit"`
+	Verbose bool          `query:"verbose"`
+	Tags    []string      `query:"tag"`
+	Timeout time.Duration `header:"X-Timeout"`
+	Since   time.Time     `header:"X-Since"`
+	Name    string        `form:"name"`
+	ignored string        `query:"ignored"`
+}
+
+func ExampleBind() {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /search/{id}", func(w http.ResponseWriter, r *http.Request) {
+		var args searchArgs
+		list, err := Bind(r, &args)
+		fmt.Printf("test: Bind() -> %+v [err:%v]\n", list, err)
+		fmt.Printf("test: Bind() -> [id:%v] [q:%v] [limit:%v] [tags:%v] [timeout:%v] [since:%v] [name:%v]\n", args.Id, args.Query, args.Limit, args.Tags, args.Timeout, args.Since.Format(time.RFC3339), args.Name)
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/search/123?q=golang&limit=10&verbose=true&tag=a,b&tag=c&ignored=true", strings.NewReader("name=test"))
+	req.Header.Set("Content-Type", ContentTypeFormUrlEncoded)
+	req.Header.Set("X-Timeout", "250ms")
+	req.Header.Set("X-Since", "2024-01-02T15:04:05Z")
+	mux.ServeHTTP(httptest.NewRecorder(), req)
+
+	//Output:
+	//test: Bind() -> [{Name:id Value:123} {Name:q Value:golang} {Name:limit Value:10} {Name:verbose Value:true} {Name:tag Value:[a,b c]} {Name:X-Timeout Value:250ms} {Name:X-Since Value:2024-01-02 15:04:05 +0000 UTC} {Name:name Value:test}] [err:<nil>]
+	//test: Bind() -> [id:123] [q:golang] [limit:10] [tags:[a,b c]] [timeout:250ms] [since:2024-01-02T15:04:05Z] [name:test]
+
+}
+
+func ExampleBind_error() {
+	var args searchArgs
+	req := httptest.NewRequest(http.MethodGet, "/search?limit=ten&verbose=yes", nil)
+	_, err := Bind(req, &args)
+	fmt.Printf("test: Bind() -> [code:%v] [err:%v]\n", err.(*Status).Code, err)
+
+	_, err = Bind(req, args)
+	fmt.Printf("test: Bind() -> [err:%v]\n", err)
+
+	//Output:
+	//test: Bind() -> [code:3] [err:Invalid Argument - path id is required
+	//query limit is invalid: strconv.ParseInt: parsing "ten": invalid syntax
+	//query verbose is invalid: strconv.ParseBool: parsing "yes": invalid syntax]
+	//test: Bind() -> [err:Invalid Argument - bind type: core.searchArgs is not a pointer to a struct]
+
+}
+
+type lookupArgs struct {
+	Id    string   `path:"id" query:"id,required"`
+	Key   []byte   `query:"key"`
+	Codes []int    `header:"X-Codes"`
+	Tags  []string `query:"tag"`
+}
+
+func ExampleBind_sources() {
+	var args lookupArgs
+	req := httptest.NewRequest(http.MethodGet, "/lookup?id=42&key=a,b&tag=x,y", nil)
+	req.Header.Add("X-Codes", "1, 2")
+	req.Header.Add("X-Codes", "3")
+	_, err := Bind(req, &args)
+	fmt.Printf("test: Bind() -> [id:%v] [key:%s] [codes:%v] [tags:%v] [err:%v]\n", args.Id, args.Key, args.Codes, args.Tags, err)
+
+	args = lookupArgs{}
+	_, err = Bind(httptest.NewRequest(http.MethodGet, "/lookup", nil), &args)
+	fmt.Printf("test: Bind() -> [err:%v]\n", err)
+
+	//Output:
+	//test: Bind() -> [id:42] [key:a,b] [codes:[1 2 3]] [tags:[x,y]] [err:<nil>]
+	//test: Bind() -> [err:Invalid Argument - query id is required]
+
+}
@@ -0,0 +1,138 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Predicate - request predicate for a branch route
+type Predicate func(r *http.Request) bool
+
+// MethodPredicate - match any of the request methods
+func MethodPredicate(methods ...string) Predicate {
+	return func(r *http.Request) bool {
+		for _, m := range methods {
+			if strings.EqualFold(r.Method, m) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// PathPrefixPredicate - match a URL path prefix, on a path segment boundary
+func PathPrefixPredicate(prefix string) Predicate {
+	prefix = strings.TrimSuffix(prefix, "/")
+	return func(r *http.Request) bool {
+		if r.URL == nil {
+			return false
+		}
+		path := r.URL.Path
+		if !strings.HasPrefix(path, prefix) {
+			return false
+		}
+		return len(path) == len(prefix) || path[len(prefix)] == '/'
+	}
+}
+
+// HeaderPredicate - match a header value, an empty value matches a header that is present
+func HeaderPredicate(key, value string) Predicate {
+	return func(r *http.Request) bool {
+		values := r.Header.Values(key)
+		if value == "" {
+			return len(values) > 0
+		}
+		for _, v := range values {
+			if v == value {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Route - a branch route, the operatives are a sub-network that continues with the next link of the branch
+type Route struct {
+	Name       string
+	Predicate  Predicate
+	Operatives []any
+}
+
+// Branch - a Chainable[Exchange] that sends a request down the sub-network of the first matching route
+type Branch struct {
+	routes    []Route
+	otherwise []any
+}
+
+// NewBranch - create a branch, requests that do not match a route use the otherwise sub-network, or if
+// otherwise is empty, the next link of the branch
+func NewBranch(routes []Route, otherwise []any) (*Branch, error) {
+	if len(routes) == 0 {
+		return nil, errors.New("branch routes list is empty")
+	}
+	for i, r := range routes {
+		if r.Predicate == nil {
+			return nil, errors.New(fmt.Sprintf("branch route predicate is nil at index: %v", i))
+		}
+		if err := validateNetwork[Exchange, Chainable[Exchange]](r.Operatives); err != nil {
+			return nil, errors.New(fmt.Sprintf("branch route %v: %v", routeName(r, i), err))
+		}
+	}
+	if len(otherwise) > 0 {
+		if err := validateNetwork[Exchange, Chainable[Exchange]](otherwise); err != nil {
+			return nil, errors.New(fmt.Sprintf("branch otherwise: %v", err))
+		}
+	}
+	b := new(Branch)
+	b.routes = routes
+	b.otherwise = otherwise
+	return b, nil
+}
+
+// Name - network link name
+func (b *Branch) Name() string {
+	names := make([]string, len(b.routes))
+	for i, r := range b.routes {
+		names[i] = routeName(r, i)
+	}
+	return fmt.Sprintf("branch(%v)", strings.Join(names, ", "))
+}
+
+// Link - link the route sub-networks to the next link
+func (b *Branch) Link(next Exchange) Exchange {
+	networks := make([]Exchange, len(b.routes))
+	for i, r := range b.routes {
+		networks[i] = linkTail(r.Operatives, next)
+	}
+	otherwise := next
+	if len(b.otherwise) > 0 {
+		otherwise = linkTail(b.otherwise, next)
+	}
+	return func(r *http.Request) (*http.Response, error) {
+		for i, route := range b.routes {
+			if route.Predicate(r) {
+				return networks[i](r)
+			}
+		}
+		if otherwise == nil {
+			return &http.Response{StatusCode: http.StatusNotFound}, errors.New(fmt.Sprintf("branch route not found for request: %v %v", r.Method, r.URL))
+		}
+		return otherwise(r)
+	}
+}
+
+// linkTail - build a sub-network that continues with the tail, operatives have been validated
+func linkTail(operatives []any, tail Exchange) Exchange {
+	ops := append(append([]any(nil), operatives...), func(next Exchange) Exchange { return tail })
+	head, _, _ := linkNetwork[Exchange, Chainable[Exchange]](ops)
+	return head
+}
+
+func routeName(r Route, i int) string {
+	if r.Name != "" {
+		return r.Name
+	}
+	return fmt.Sprintf("route-%v", i)
+}
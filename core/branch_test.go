@@ -0,0 +1,104 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+func statusExchangeFn(name string, code int) func(next Exchange) Exchange {
+	return func(next Exchange) Exchange {
+		return func(req *http.Request) (*http.Response, error) {
+			fmt.Printf("test: %v-Exchange() -> request\n", name)
+			if next != nil {
+				return next(req)
+			}
+			return &http.Response{StatusCode: code, Header: http.Header{"X-Name": {name}}}, nil
+		}
+	}
+}
+
+func ExampleNewBranch() {
+	b, err := NewBranch([]Route{
+		{Name: "post", Predicate: MethodPredicate(http.MethodPost, http.MethodPut), Operatives: []any{statusExchangeFn("write", http.StatusOK)}},
+		{Name: "search", Predicate: PathPrefixPredicate("/search"), Operatives: []any{do1ExchangeFn}},
+		{Name: "trace", Predicate: HeaderPredicate("X-Trace", ""), Operatives: []any{do2ExchangeFn}},
+	}, nil)
+	fmt.Printf("test: NewBranch() -> %v [err:%v]\n", b.Name(), err)
+
+	ex := BuildNetwork([]any{b, statusExchangeFn("next", http.StatusAccepted)})
+	req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://localhost/search?q=golang", nil)
+	resp, err := ex(req)
+	fmt.Printf("test: Exchange(\"/search\") -> [status:%v] [err:%v]\n", resp.StatusCode, err)
+
+	req, _ = http.NewRequestWithContext(context.Background(), http.MethodGet, "https://localhost/searches", nil)
+	resp, err = ex(req)
+	fmt.Printf("test: Exchange(\"/searches\") -> [status:%v] [err:%v]\n", resp.StatusCode, err)
+
+	req, _ = http.NewRequestWithContext(context.Background(), http.MethodPut, "https://localhost/search", nil)
+	resp, err = ex(req)
+	fmt.Printf("test: Exchange(\"PUT\") -> [status:%v] [err:%v]\n", resp.StatusCode, err)
+
+	//Output:
+	//test: NewBranch() -> branch(post, search, trace) [err:<nil>]
+	//test: Do1-Exchange() -> request
+	//test: next-Exchange() -> request
+	//test: Do1-Exchange() -> response
+	//test: Exchange("/search") -> [status:202] [err:<nil>]
+	//test: next-Exchange() -> request
+	//test: Exchange("/searches") -> [status:202] [err:<nil>]
+	//test: write-Exchange() -> request
+	//test: next-Exchange() -> request
+	//test: Exchange("PUT") -> [status:202] [err:<nil>]
+
+}
+
+func ExampleNewBranch_otherwise() {
+	b, _ := NewBranch([]Route{
+		{Predicate: HeaderPredicate("X-Version", "2"), Operatives: []any{statusExchangeFn("v2", http.StatusOK)}},
+	}, []any{statusExchangeFn("v1", http.StatusOK)})
+	ex := BuildNetwork([]any{b})
+
+	req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://localhost/search", nil)
+	req.Header.Set("X-Version", "2")
+	resp, err := ex(req)
+	fmt.Printf("test: Exchange(\"2\") -> [status:%v] [name:%v] [err:%v]\n", resp.StatusCode, resp.Header.Get("X-Name"), err)
+
+	req.Header.Set("X-Version", "1")
+	resp, err = ex(req)
+	fmt.Printf("test: Exchange(\"1\") -> [status:%v] [name:%v] [err:%v]\n", resp.StatusCode, resp.Header.Get("X-Name"), err)
+
+	b, _ = NewBranch([]Route{{Predicate: MethodPredicate(http.MethodPost), Operatives: []any{do1ExchangeFn}}}, nil)
+	resp, err = BuildNetwork([]any{b})(req)
+	fmt.Printf("test: Exchange(\"GET\") -> [status:%v] [err:%v]\n", resp.StatusCode, err)
+
+	_, err = NewBranch([]Route{{Predicate: MethodPredicate(http.MethodPost), Operatives: []any{req}}}, nil)
+	fmt.Printf("test: NewBranch() -> [err:%v]\n", err)
+
+	//Output:
+	//test: v2-Exchange() -> request
+	//test: Exchange("2") -> [status:200] [name:v2] [err:<nil>]
+	//test: v1-Exchange() -> request
+	//test: Exchange("1") -> [status:200] [name:v1] [err:<nil>]
+	//test: Exchange("GET") -> [status:404] [err:branch route not found for request: GET https://localhost/search]
+	//test: NewBranch() -> [err:branch route route-0: invalid operative type: *http.Request]
+
+}
+
+func ExampleNewBranch_links() {
+	links := 0
+	counted := func(next Exchange) Exchange {
+		links++
+		return next
+	}
+	b, _ := NewBranch([]Route{{Predicate: MethodPredicate(http.MethodPost), Operatives: []any{counted}}}, []any{counted})
+	fmt.Printf("test: NewBranch() -> [links:%v]\n", links)
+
+	BuildNetwork([]any{b, statusExchangeFn("next", http.StatusOK)})
+	fmt.Printf("test: BuildNetwork() -> [links:%v]\n", links)
+
+	//Output:
+	//test: NewBranch() -> [links:0]
+	//test: BuildNetwork() -> [links:2]
+
+}
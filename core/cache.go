@@ -0,0 +1,248 @@
+package core
+
+import (
+	"container/list"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// CacheConfig - cache configuration, a zero MaxEntries or TTL is unbounded, and a nil Clock is the SystemClock
+type CacheConfig[K comparable, V any] struct {
+	MaxEntries int
+	TTL        time.Duration
+	Loader     func(key K) (V, error)
+	Clock      Clock
+}
+
+// CacheStats - cache statistics
+type CacheStats struct {
+	Hits        uint64
+	Misses      uint64
+	Loads       uint64
+	Evictions   uint64 // Removed by the MaxEntries bound
+	Expirations uint64 // Removed as the TTL has elapsed
+}
+
+// Cache - concurrency safe cache with per entry TTL and an LRU bound on the number of entries
+type Cache[K comparable, V any] struct {
+	mu     sync.Mutex
+	max    int
+	ttl    time.Duration
+	loader func(key K) (V, error)
+	clock  Clock
+	items  map[K]*list.Element
+	lru    *list.List
+	calls  map[K]*cacheCall[V]
+	stats  CacheStats
+	sweep  time.Time
+}
+
+type cacheEntry[K comparable, V any] struct {
+	key     K
+	value   V
+	expires time.Time
+}
+
+type cacheCall[V any] struct {
+	wg    sync.WaitGroup
+	value V
+	err   error
+	stale bool // Set, Delete or Purge during the load, so the value is not stored
+}
+
+// NewCache - create a cache
+func NewCache[K comparable, V any](cfg CacheConfig[K, V]) *Cache[K, V] {
+	c := new(Cache[K, V])
+	c.max = cfg.MaxEntries
+	c.ttl = cfg.TTL
+	c.loader = cfg.Loader
+	c.clock = cfg.Clock
+	if c.clock == nil {
+		c.clock = SystemClock
+	}
+	c.items = make(map[K]*list.Element)
+	c.lru = list.New()
+	c.calls = make(map[K]*cacheCall[V])
+	return c
+}
+
+// Get - get an entry, without loading
+func (c *Cache[K, V]) Get(key K) (v V, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if v, ok = c.get(key); ok {
+		c.stats.Hits++
+	} else {
+		c.stats.Misses++
+	}
+	return v, ok
+}
+
+// GetOrLoad - get an entry, using the loader on a miss. Concurrent misses for a key share one loader call,
+// and loader errors are not cached. A loader panic is returned as an error. The loaded value is not stored if
+// the key is set or deleted, or the cache is purged, during the load.
+func (c *Cache[K, V]) GetOrLoad(key K) (V, error) {
+	c.mu.Lock()
+	if v, ok := c.get(key); ok {
+		c.stats.Hits++
+		c.mu.Unlock()
+		return v, nil
+	}
+	c.stats.Misses++
+	if c.loader == nil {
+		c.mu.Unlock()
+		var v V
+		return v, errors.New("cache loader is nil")
+	}
+	if call, ok := c.calls[key]; ok {
+		c.mu.Unlock()
+		call.wg.Wait()
+		return call.value, call.err
+	}
+	call := new(cacheCall[V])
+	call.wg.Add(1)
+	c.calls[key] = call
+	c.stats.Loads++
+	c.mu.Unlock()
+
+	c.load(key, call)
+	return call.value, call.err
+}
+
+// load - call the loader, a loader panic is recovered into the call error so waiting callers are released
+func (c *Cache[K, V]) load(key K, call *cacheCall[V]) {
+	defer func() {
+		if r := recover(); r != nil {
+			var v V
+			call.value = v
+			call.err = errors.New(fmt.Sprintf("cache loader panic: %v", r))
+		}
+		c.mu.Lock()
+		delete(c.calls, key)
+		if call.err == nil && !call.stale {
+			c.set(key, call.value, c.ttl)
+		}
+		c.mu.Unlock()
+		call.wg.Done()
+	}()
+	call.value, call.err = c.loader(key)
+}
+
+// Set - set an entry with the configured TTL
+func (c *Cache[K, V]) Set(key K, v V) {
+	c.SetWithTTL(key, v, c.ttl)
+}
+
+// SetWithTTL - set an entry with a TTL, a zero TTL does not expire
+func (c *Cache[K, V]) SetWithTTL(key K, v V, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.invalidate(key)
+	c.set(key, v, ttl)
+}
+
+// Delete - delete an entry
+func (c *Cache[K, V]) Delete(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.invalidate(key)
+	if e, ok := c.items[key]; ok {
+		c.remove(e)
+	}
+}
+
+// Purge - delete all entries
+func (c *Cache[K, V]) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, call := range c.calls {
+		call.stale = true
+	}
+	c.items = make(map[K]*list.Element)
+	c.lru.Init()
+}
+
+// Len - number of entries, including expired entries that have not been removed. Expired entries are removed
+// when read, and are swept when an entry is set, at most once per TTL.
+func (c *Cache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len()
+}
+
+// Stats - cache statistics
+func (c *Cache[K, V]) Stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats
+}
+
+func (c *Cache[K, V]) get(key K) (v V, ok bool) {
+	e, ok := c.items[key]
+	if !ok {
+		return v, false
+	}
+	entry := e.Value.(*cacheEntry[K, V])
+	if !entry.expires.IsZero() && !c.clock.Now().Before(entry.expires) {
+		c.remove(e)
+		c.stats.Expirations++
+		return v, false
+	}
+	c.lru.MoveToFront(e)
+	return entry.value, true
+}
+
+func (c *Cache[K, V]) set(key K, v V, ttl time.Duration) {
+	var expires time.Time
+	if ttl > 0 {
+		now := c.clock.Now()
+		expires = now.Add(ttl)
+		c.removeExpired(now, ttl)
+	}
+	if e, ok := c.items[key]; ok {
+		entry := e.Value.(*cacheEntry[K, V])
+		entry.value = v
+		entry.expires = expires
+		c.lru.MoveToFront(e)
+		return
+	}
+	c.items[key] = c.lru.PushFront(&cacheEntry[K, V]{key: key, value: v, expires: expires})
+	for c.max > 0 && c.lru.Len() > c.max {
+		c.remove(c.lru.Back())
+		c.stats.Evictions++
+	}
+}
+
+// removeExpired - sweep expired entries, so keys that are not read again are removed. The sweep runs at most
+// once per TTL, so the cost of a full scan is shared by the entries set in that time.
+func (c *Cache[K, V]) removeExpired(now time.Time, ttl time.Duration) {
+	if now.Before(c.sweep) {
+		return
+	}
+	if c.ttl > 0 {
+		ttl = c.ttl
+	}
+	c.sweep = now.Add(ttl)
+	for e := c.lru.Back(); e != nil; {
+		prev := e.Prev()
+		if entry := e.Value.(*cacheEntry[K, V]); !entry.expires.IsZero() && !now.Before(entry.expires) {
+			c.remove(e)
+			c.stats.Expirations++
+		}
+		e = prev
+	}
+}
+
+// invalidate - a load in progress for the key does not store its value
+func (c *Cache[K, V]) invalidate(key K) {
+	if call, ok := c.calls[key]; ok {
+		call.stale = true
+	}
+}
+
+func (c *Cache[K, V]) remove(e *list.Element) {
+	c.lru.Remove(e)
+	delete(c.items, e.Value.(*cacheEntry[K, V]).key)
+}
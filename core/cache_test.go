@@ -0,0 +1,186 @@
+package core
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+type testClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *testClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func ExampleNewCache() {
+	clock := &testClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	c := NewCache[string, int](CacheConfig[string, int]{MaxEntries: 2, TTL: time.Second * 10, Clock: clock})
+
+	c.Set("one", 1)
+	c.Set("two", 2)
+	v, ok := c.Get("one")
+	fmt.Printf("test:  Get(\"one\") -> %v [ok:%v]\n", v, ok)
+
+	// "two" is the least recently used
+	c.Set("three", 3)
+	v, ok = c.Get("two")
+	fmt.Printf("test:  Get(\"two\") -> %v [ok:%v]\n", v, ok)
+
+	c.SetWithTTL("four", 4, time.Second*30)
+	clock.Advance(time.Second * 10)
+	v, ok = c.Get("three")
+	fmt.Printf("test:  Get(\"three\") -> %v [ok:%v]\n", v, ok)
+	v, ok = c.Get("four")
+	fmt.Printf("test:  Get(\"four\") -> %v [ok:%v]\n", v, ok)
+	fmt.Printf("test:  Stats() -> %+v [len:%v]\n", c.Stats(), c.Len())
+
+	//Output:
+	//test:  Get("one") -> 1 [ok:true]
+	//test:  Get("two") -> 0 [ok:false]
+	//test:  Get("three") -> 0 [ok:false]
+	//test:  Get("four") -> 4 [ok:true]
+	//test:  Stats() -> {Hits:2 Misses:2 Loads:0 Evictions:2 Expirations:1} [len:1]
+
+}
+
+func ExampleCache_GetOrLoad() {
+	var mu sync.Mutex
+	calls := 0
+	release := make(chan struct{})
+	c := NewCache[string, string](CacheConfig[string, string]{Loader: func(key string) (string, error) {
+		mu.Lock()
+		calls++
+		mu.Unlock()
+		<-release
+		return "value:" + key, nil
+	}})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c.GetOrLoad("key")
+		}()
+	}
+	for c.Stats().Misses < 5 {
+		time.Sleep(time.Millisecond)
+	}
+	close(release)
+	wg.Wait()
+
+	v, err := c.GetOrLoad("key")
+	fmt.Printf("test:  GetOrLoad(\"key\") -> %v [err:%v] [calls:%v]\n", v, err, calls)
+	fmt.Printf("test:  Stats() -> %+v\n", c.Stats())
+
+	//Output:
+	//test:  GetOrLoad("key") -> value:key [err:<nil>] [calls:1]
+	//test:  Stats() -> {Hits:1 Misses:5 Loads:1 Evictions:0 Expirations:0}
+
+}
+
+func ExampleCache_GetOrLoad_panic() {
+	calls := 0
+	release := make(chan struct{})
+	c := NewCache[string, string](CacheConfig[string, string]{Loader: func(key string) (string, error) {
+		calls++
+		if calls == 1 {
+			<-release
+			panic("loader failure")
+		}
+		return "value:" + key, nil
+	}})
+
+	var wg sync.WaitGroup
+	errs := make([]error, 3)
+	for i := range errs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, errs[i] = c.GetOrLoad("key")
+		}()
+	}
+	for c.Stats().Misses < 3 {
+		time.Sleep(time.Millisecond)
+	}
+	close(release)
+	wg.Wait()
+	fmt.Printf("test:  GetOrLoad(\"key\") -> [err:%v] [err:%v] [err:%v]\n", errs[0], errs[1], errs[2])
+
+	v, err := c.GetOrLoad("key")
+	fmt.Printf("test:  GetOrLoad(\"key\") -> %v [err:%v] [calls:%v]\n", v, err, calls)
+
+	//Output:
+	//test:  GetOrLoad("key") -> [err:cache loader panic: loader failure] [err:cache loader panic: loader failure] [err:cache loader panic: loader failure]
+	//test:  GetOrLoad("key") -> value:key [err:<nil>] [calls:2]
+
+}
+
+func ExampleCache_Set_expired() {
+	clock := &testClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	c := NewCache[string, int](CacheConfig[string, int]{TTL: time.Second * 10, Clock: clock})
+
+	// Expired keys that are not read again are swept when an entry is set
+	c.Set("one", 1)
+	c.Set("two", 2)
+	c.SetWithTTL("three", 3, time.Second*30)
+	clock.Advance(time.Second * 10)
+	c.Set("four", 4)
+	fmt.Printf("test:  Set(\"four\") -> [len:%v] [expirations:%v]\n", c.Len(), c.Stats().Expirations)
+
+	//Output:
+	//test:  Set("four") -> [len:2] [expirations:2]
+
+}
+
+func ExampleCache_GetOrLoad_stale() {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	c := NewCache[string, string](CacheConfig[string, string]{Loader: func(key string) (string, error) {
+		started <- struct{}{}
+		<-release
+		return "loaded:" + key, nil
+	}})
+
+	// A Set during the load is newer than the loaded value
+	done := make(chan string)
+	go func() {
+		v, _ := c.GetOrLoad("key")
+		done <- v
+	}()
+	<-started
+	c.Set("key", "newer")
+	close(release)
+	loaded := <-done
+	v, ok := c.Get("key")
+	fmt.Printf("test:  GetOrLoad(\"key\") -> %v [get:%v] [ok:%v]\n", loaded, v, ok)
+
+	// A Delete during the load removes the loaded value
+	release = make(chan struct{})
+	go func() {
+		v, _ := c.GetOrLoad("other")
+		done <- v
+	}()
+	<-started
+	c.Delete("other")
+	close(release)
+	loaded = <-done
+	_, ok = c.Get("other")
+	fmt.Printf("test:  GetOrLoad(\"other\") -> %v [ok:%v]\n", loaded, ok)
+
+	//Output:
+	//test:  GetOrLoad("key") -> loaded:key [get:newer] [ok:true]
+	//test:  GetOrLoad("other") -> loaded:other [ok:false]
+
+}
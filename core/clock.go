@@ -0,0 +1,17 @@
+package core
+
+import "time"
+
+var (
+	SystemClock Clock = ClockFunc(time.Now)
+)
+
+// Clock - injectable time source
+type Clock interface {
+	Now() time.Time
+}
+
+// ClockFunc - adapter to use a function as a Clock
+type ClockFunc func() time.Time
+
+func (f ClockFunc) Now() time.Time { return f() }
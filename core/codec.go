@@ -0,0 +1,226 @@
+package core
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"reflect"
+	"strings"
+)
+
+const (
+	ContentTypeXml            = "application/xml"
+	ContentTypeFormUrlEncoded = "application/x-www-form-urlencoded"
+	ContentTypeNdJson         = "application/x-ndjson"
+	ContentTypeCbor           = "application/cbor"
+
+	jsonToken  = "json"
+	jsonSuffix = "+json"
+	xmlSuffix  = "+xml"
+)
+
+// Codec - content marshalling for a media type
+type Codec interface {
+	Marshal(v any) ([]byte, error)
+	Unmarshal(buf []byte, v any) error
+}
+
+// StreamCodec - optional streaming support for a codec
+type StreamCodec interface {
+	Decode(r io.Reader, v any) error
+	Encode(w io.Writer, v any) error
+}
+
+var (
+	codecs = NewSyncMap[string, Codec]()
+)
+
+func init() {
+	RegisterCodec(ContentTypeJson, jsonCodec{})
+	RegisterCodec(ContentTypeXml, xmlCodec{})
+	RegisterCodec("text/xml", xmlCodec{})
+	RegisterCodec(ContentTypeFormUrlEncoded, formCodec{})
+	RegisterCodec(ContentTypeNdJson, ndjsonCodec{})
+}
+
+// RegisterCodec - register a codec for a media type, replacing any existing codec. There is no built-in codec
+// for application/cbor.
+func RegisterCodec(mediaType string, c Codec) {
+	if c == nil {
+		return
+	}
+	codecs.Store(MediaType(mediaType), c)
+}
+
+// LookupCodec - find a codec for a content type, parameters such as charset are ignored. Vendor types with
+// a +json or +xml structured syntax suffix use the JSON or XML codec if not registered.
+func LookupCodec(contentType string) (Codec, bool) {
+	mt := MediaType(contentType)
+	if mt == "" {
+		return nil, false
+	}
+	if c, ok := codecs.Load(mt); ok {
+		return c, true
+	}
+	if strings.HasSuffix(mt, jsonSuffix) {
+		return codecs.Load(ContentTypeJson)
+	}
+	if strings.HasSuffix(mt, xmlSuffix) {
+		return codecs.Load(ContentTypeXml)
+	}
+	return nil, false
+}
+
+// MarshalCodec - find a codec for marshalling a content type. Without a registered codec, a media type containing
+// json uses the JSON codec, and if required is false so does any other media type, as before codecs were registered.
+func MarshalCodec(contentType string, required bool) (Codec, bool) {
+	if c, ok := LookupCodec(contentType); ok {
+		return c, true
+	}
+	if !required || strings.Contains(MediaType(contentType), jsonToken) {
+		return codecs.Load(ContentTypeJson)
+	}
+	return nil, false
+}
+
+// MediaType - lower case media type of a content type, without parameters
+func MediaType(contentType string) string {
+	if i := strings.IndexAny(contentType, "; \t"); i >= 0 {
+		contentType = contentType[:i]
+	}
+	return strings.ToLower(strings.TrimSpace(contentType))
+}
+
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(v any) ([]byte, error)     { return json.Marshal(v) }
+func (jsonCodec) Unmarshal(buf []byte, v any) error { return json.Unmarshal(buf, v) }
+
+// Decode - as with json.Unmarshal, data after the value is an error
+func (jsonCodec) Decode(r io.Reader, v any) error {
+	dec := json.NewDecoder(r)
+	if err := dec.Decode(v); err != nil {
+		return err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		var se *json.SyntaxError
+		if err == nil || errors.As(err, &se) {
+			return errors.New("invalid data after top-level value")
+		}
+		return err
+	}
+	return nil
+}
+
+// Encode - json.Encoder buffers the encoding, and appends a newline, so json.Marshal is used
+func (jsonCodec) Encode(w io.Writer, v any) error {
+	buf, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(buf)
+	return err
+}
+
+type xmlCodec struct{}
+
+func (xmlCodec) Marshal(v any) ([]byte, error)     { return xml.Marshal(v) }
+func (xmlCodec) Unmarshal(buf []byte, v any) error { return xml.Unmarshal(buf, v) }
+func (xmlCodec) Decode(r io.Reader, v any) error   { return xml.NewDecoder(r).Decode(v) }
+func (xmlCodec) Encode(w io.Writer, v any) error   { return xml.NewEncoder(w).Encode(v) }
+
+// formCodec - url.Values, map[string][]string, and map[string]string are supported
+type formCodec struct{}
+
+func (formCodec) Marshal(v any) ([]byte, error) {
+	switch ptr := v.(type) {
+	case url.Values:
+		return []byte(ptr.Encode()), nil
+	case map[string][]string:
+		return []byte(url.Values(ptr).Encode()), nil
+	case map[string]string:
+		values := make(url.Values, len(ptr))
+		for k, s := range ptr {
+			values.Set(k, s)
+		}
+		return []byte(values.Encode()), nil
+	}
+	return nil, errors.New(fmt.Sprintf("form type: %v is not supported for marshalling", reflect.TypeOf(v)))
+}
+
+func (formCodec) Unmarshal(buf []byte, v any) error {
+	values, err := url.ParseQuery(string(buf))
+	if err != nil {
+		return err
+	}
+	switch ptr := v.(type) {
+	case *url.Values:
+		*ptr = values
+	case *map[string][]string:
+		*ptr = values
+	case *map[string]string:
+		m := make(map[string]string, len(values))
+		for k := range values {
+			m[k] = values.Get(k)
+		}
+		*ptr = m
+	default:
+		return errors.New(fmt.Sprintf("form type: %v is not supported for unmarshalling", reflect.TypeOf(v)))
+	}
+	return nil
+}
+
+// ndjsonCodec - newline delimited JSON, values are slices
+type ndjsonCodec struct{}
+
+func (c ndjsonCodec) Marshal(v any) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	if err := c.Encode(buf, v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (c ndjsonCodec) Unmarshal(buf []byte, v any) error {
+	return c.Decode(bytes.NewReader(buf), v)
+}
+
+func (ndjsonCodec) Encode(w io.Writer, v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return errors.New(fmt.Sprintf("ndjson type: %v is not a slice", reflect.TypeOf(v)))
+	}
+	enc := json.NewEncoder(w)
+	for i := 0; i < rv.Len(); i++ {
+		if err := enc.Encode(rv.Index(i).Interface()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ndjsonCodec) Decode(r io.Reader, v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.Elem().Kind() != reflect.Slice {
+		return errors.New(fmt.Sprintf("ndjson type: %v is not a pointer to a slice", reflect.TypeOf(v)))
+	}
+	slice := rv.Elem()
+	dec := json.NewDecoder(r)
+	for {
+		elem := reflect.New(slice.Type().Elem())
+		err := dec.Decode(elem.Interface())
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		slice = reflect.Append(slice, elem.Elem())
+	}
+	rv.Elem().Set(slice)
+	return nil
+}
@@ -0,0 +1,105 @@
+package core
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+)
+
+type rate struct {
+	Name  string  `json:"name" xml:"name"`
+	Value float64 `json:"value" xml:"value"`
+}
+
+type upperCodec struct{}
+
+func (upperCodec) Marshal(v any) ([]byte, error) {
+	return []byte(strings.ToUpper(fmt.Sprintf("%v", v))), nil
+}
+
+func (upperCodec) Unmarshal(buf []byte, v any) error {
+	if ptr, ok := v.(*rate); ok {
+		ptr.Name = strings.ToLower(string(buf))
+		return nil
+	}
+	return fmt.Errorf("type %T is not supported", v)
+}
+
+func ExampleLookupCodec() {
+	for _, ct := range []string{"application/json", "Application/JSON; charset=utf-8", "application/vnd.acme.rate+json", "application/atom+xml", "text/plain charset=utf-8", "application/cbor", ""} {
+		_, ok := LookupCodec(ct)
+		fmt.Printf("test: LookupCodec(\"%v\") -> [media-type:%v] [ok:%v]\n", ct, MediaType(ct), ok)
+	}
+
+	//Output:
+	//test: LookupCodec("application/json") -> [media-type:application/json] [ok:true]
+	//test: LookupCodec("Application/JSON; charset=utf-8") -> [media-type:application/json] [ok:true]
+	//test: LookupCodec("application/vnd.acme.rate+json") -> [media-type:application/vnd.acme.rate+json] [ok:true]
+	//test: LookupCodec("application/atom+xml") -> [media-type:application/atom+xml] [ok:true]
+	//test: LookupCodec("text/plain charset=utf-8") -> [media-type:text/plain] [ok:false]
+	//test: LookupCodec("application/cbor") -> [media-type:application/cbor] [ok:false]
+	//test: LookupCodec("") -> [media-type:] [ok:false]
+
+}
+
+func ExampleUnmarshal_codec() {
+	r, err := Unmarshal[rate](&Content{Type: "application/xml; charset=utf-8", Value: []byte("<rate><name>standard</name><value>1.5</value></rate>")})
+	fmt.Printf("test: Unmarshal[rate](xml) -> [%v] [err:%v]\n", r, err)
+
+	r, err = Unmarshal[rate](&Content{Type: "application/vnd.acme.rate+json", Value: []byte(`{"name":"premium","value":2.25}`)})
+	fmt.Printf("test: Unmarshal[rate](+json) -> [%v] [err:%v]\n", r, err)
+
+	v, err1 := Unmarshal[url.Values](&Content{Type: ContentTypeFormUrlEncoded, Value: []byte("name=basic&value=1")})
+	fmt.Printf("test: Unmarshal[url.Values](form) -> [%v] [err:%v]\n", v, err1)
+
+	list, err2 := Unmarshal[[]rate](&Content{Type: ContentTypeNdJson, Value: []byte("{\"name\":\"a\",\"value\":1}\n\n{\"name\":\"b\",\"value\":2}\n")})
+	fmt.Printf("test: Unmarshal[[]rate](ndjson) -> [%v] [err:%v]\n", list, err2)
+
+	_, err = Unmarshal[rate](&Content{Type: ContentTypeCbor, Value: []byte{0xa0}})
+	fmt.Printf("test: Unmarshal[rate](cbor) -> [err:%v]\n", err)
+
+	RegisterCodec("application/x-upper", upperCodec{})
+	r, err = Unmarshal[rate](&Content{Type: "application/x-upper", Value: []byte("CUSTOM")})
+	fmt.Printf("test: Unmarshal[rate](x-upper) -> [%v] [err:%v]\n", r, err)
+
+	//Output:
+	//test: Unmarshal[rate](xml) -> [{standard 1.5}] [err:<nil>]
+	//test: Unmarshal[rate](+json) -> [{premium 2.25}] [err:<nil>]
+	//test: Unmarshal[url.Values](form) -> [map[name:[basic] value:[1]]] [err:<nil>]
+	//test: Unmarshal[[]rate](ndjson) -> [[{a 1} {b 2}]] [err:<nil>]
+	//test: Unmarshal[rate](cbor) -> [err:content type: application/cbor is invalid, no codec is registered]
+	//test: Unmarshal[rate](x-upper) -> [{custom 0}] [err:<nil>]
+
+}
+
+func ExampleMarshal_codec() {
+	buf, err := Marshal[[]byte](&Content{Type: ContentTypeXml, Value: rate{Name: "standard", Value: 1.5}})
+	fmt.Printf("test: Marshal[[]byte](xml) -> [%v] [err:%v]\n", string(buf), err)
+
+	r, err1 := Marshal[io.Reader](&Content{Type: ContentTypeNdJson, Value: []rate{{Name: "a", Value: 1}, {Name: "b", Value: 2}}})
+	buf, _ = io.ReadAll(r)
+	fmt.Printf("test: Marshal[io.Reader](ndjson) -> [%v] [err:%v]\n", strings.ReplaceAll(string(buf), "\n", "\\n"), err1)
+
+	buf, err = Marshal[[]byte](&Content{Type: ContentTypeFormUrlEncoded, Value: map[string]string{"b": "2", "a": "1 2"}})
+	fmt.Printf("test: Marshal[[]byte](form) -> [%v] [err:%v]\n", string(buf), err)
+
+	buf, err = Marshal[[]byte](&Content{Type: "application/x-unknown", Value: rate{Name: "unknown"}})
+	fmt.Printf("test: Marshal[[]byte](x-unknown) -> [%v] [err:%v]\n", string(buf), err)
+
+	buf, err = Marshal[[]byte](&Content{Type: "application/vnd.x+json", Value: rate{Name: "vendor"}})
+	fmt.Printf("test: Marshal[[]byte](vnd.x+json) -> [%v] [err:%v]\n", string(buf), err)
+
+	_, ok := MarshalCodec("text/json", true)
+	_, ok1 := MarshalCodec("application/x-unknown", true)
+	fmt.Printf("test: MarshalCodec(required) -> [text/json:%v] [x-unknown:%v]\n", ok, ok1)
+
+	//Output:
+	//test: Marshal[[]byte](xml) -> [<rate><name>standard</name><value>1.5</value></rate>] [err:<nil>]
+	//test: Marshal[io.Reader](ndjson) -> [{"name":"a","value":1}\n{"name":"b","value":2}\n] [err:<nil>]
+	//test: Marshal[[]byte](form) -> [a=1+2&b=2] [err:<nil>]
+	//test: Marshal[[]byte](x-unknown) -> [{"name":"unknown","value":0}] [err:<nil>]
+	//test: Marshal[[]byte](vnd.x+json) -> [{"name":"vendor","value":0}] [err:<nil>]
+	//test: MarshalCodec(required) -> [text/json:true] [x-unknown:false]
+
+}
@@ -2,11 +2,12 @@ package core
 
 import (
 	"bytes"
-	"encoding/json"
 	"errors"
 	"fmt"
+	"github.com/appellative-ai/common/fmtx"
 	"io"
 	"reflect"
+	"strings"
 )
 
 const (
@@ -14,19 +15,33 @@ const (
 	ContentTypeBinary   = "application/octet-stream"
 	ContentTypeJson     = "application/json"
 	ContentTypeTextHtml = "text/html"
+
+	textPrefix = "text/"
 )
 
-// Content -
+// Content - a Value of []byte or io.Reader is decoded by Unmarshal, an io.Reader is streamed and Limit, if set,
+// is the maximum number of bytes read.
 type Content struct {
 	Fragment string // returned on a Get
 	Type     string // Content-Type
 	Value    any
+	Limit    int64 // Optional byte limit when unmarshalling
 }
 
 func (c Content) String() string {
 	return fmt.Sprintf("fragment: %v type: %v value: %v", c.Fragment, c.Type, c.Value != nil)
 }
 
+// SetLimit - set the Limit from a byte size, as in 512k, 10MB or 1.5MiB, see fmtx.ParseByteSize
+func (c *Content) SetLimit(size string) error {
+	n, err := fmtx.ParseByteSize(size)
+	if err != nil {
+		return err
+	}
+	c.Limit = n
+	return nil
+}
+
 func (c Content) Valid(contentType string) bool {
 	return c.Value != nil && c.Type == contentType
 }
@@ -38,59 +53,129 @@ func New[T any](ct *Content) (t T, err error) {
 	if ct.Type == "" || ct.Value == nil {
 		return t, errors.New(fmt.Sprintf("content type is empty, or content value is nil"))
 	}
-	// Check for binary and unmarshal
-	if _, ok := ct.Value.([]byte); ok {
-		return Unmarshal[T](ct)
-	}
 	var ok bool
 
 	if t, ok = ct.Value.(T); ok {
 		return t, nil
 	}
+	// Check for binary or a reader and unmarshal
+	switch ct.Value.(type) {
+	case []byte, io.Reader:
+		return Unmarshal[T](ct)
+	}
 	return t, errors.New(fmt.Sprintf("content value type: %v is not of generic type: %v", reflect.TypeOf(ct.Value), reflect.TypeOf(t)))
 }
 
-// Unmarshal - []byte -> string, []byte, io.Reader, type via json.Unmarshal
+// Unmarshal - []byte | io.Reader -> string, []byte, io.Reader, type via the codec registered for the content type.
+// An io.Reader is decoded as a stream if the codec supports it, and is closed once decoded if it is an io.Closer.
+// An io.Reader generic type is returned the reader, which is not closed.
+// JSON content is validated against a schema registered for the generic type or the content type, and violations
+// are returned as a *Status with StatusInvalidContent.
 func Unmarshal[T any](ct *Content) (t T, err error) {
-	var body []byte
-	var ok bool
-
 	if ct == nil {
 		return t, errors.New(fmt.Sprintf("content is nil"))
 	}
 	if ct.Type == "" || ct.Value == nil {
 		return t, errors.New(fmt.Sprintf("content type is empty, or content value is nil"))
 	}
-	if body, ok = ct.Value.([]byte); !ok {
-		return t, errors.New(fmt.Sprintf("content value type: %v is not of type: []byte", reflect.TypeOf(ct.Value)))
+	var schema *Schema
+	if isJson(MediaType(ct.Type)) {
+		schema = lookupSchema(reflect.TypeOf((*T)(nil)).Elem(), ct.Type)
 	}
-	if len(body) == 0 {
-		return t, nil
+	switch v := ct.Value.(type) {
+	case []byte:
+		if ct.Limit > 0 && int64(len(v)) > ct.Limit {
+			return t, limitError(ct.Limit)
+		}
+		if len(v) == 0 {
+			return t, nil
+		}
+		err = unmarshal(ct.Type, v, &t, schema)
+		return t, err
+	case io.Reader:
+		if p, ok := any(&t).(*io.Reader); ok {
+			*p = newLimitReadCloser(v, ct.Limit)
+			return t, nil
+		}
+		if c, ok := v.(io.Closer); ok {
+			defer c.Close()
+		}
+		err = decode(ct.Type, newLimitReader(v, ct.Limit), &t, schema)
+		return t, err
 	}
-	switch ptr := any(&t).(type) {
+	return t, errors.New(fmt.Sprintf("content value type: %v is not of type: []byte or io.Reader", reflect.TypeOf(ct.Value)))
+}
+
+func unmarshal(contentType string, body []byte, v any, schema *Schema) error {
+	mt := MediaType(contentType)
+	switch ptr := v.(type) {
 	case *string:
-		if ct.Type != ContentTypeText && ct.Type != ContentTypeTextHtml {
-			return t, errors.New(fmt.Sprintf("content type: %v is invalid for string", ct.Type))
+		if !strings.HasPrefix(mt, textPrefix) {
+			return errors.New(fmt.Sprintf("content type: %v is invalid for string", contentType))
 		}
 		*ptr = string(body)
 	case *[]byte:
-		if ct.Type != ContentTypeBinary {
-			return t, errors.New(fmt.Sprintf("content type: %v is invalid for []byte", ct.Type))
+		if mt != ContentTypeBinary {
+			return errors.New(fmt.Sprintf("content type: %v is invalid for []byte", contentType))
 		}
 		*ptr = body
+	case *io.Reader:
+		*ptr = bytes.NewReader(body)
 	default:
-		if ct.Type != ContentTypeJson {
-			return t, errors.New(fmt.Sprintf("content type: %v is invalid for json.Unmarshal()", ct.Type))
+		codec, ok := LookupCodec(mt)
+		if !ok {
+			return errors.New(fmt.Sprintf("content type: %v is invalid, no codec is registered", contentType))
 		}
-		err := json.Unmarshal(body, ptr)
+		if schema != nil {
+			if err := schema.ValidateJSON(body); err != nil {
+				return err
+			}
+		}
+		err := codec.Unmarshal(body, ptr)
 		if err != nil {
-			return t, errors.New(fmt.Sprintf("JSON unmarshalling %v", err))
+			return errors.New(fmt.Sprintf("%v unmarshalling %v", mt, err))
 		}
 	}
-	return t, nil
+	return nil
 }
 
-// Marshal -  type -> []byte | io.Reader
+func decode(contentType string, r io.Reader, v any, schema *Schema) error {
+	mt := MediaType(contentType)
+	switch ptr := v.(type) {
+	case *string, *[]byte:
+		body, err := io.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		if len(body) == 0 {
+			return nil
+		}
+		return unmarshal(contentType, body, ptr, nil)
+	}
+	codec, ok := LookupCodec(mt)
+	if !ok {
+		return errors.New(fmt.Sprintf("content type: %v is invalid, no codec is registered", contentType))
+	}
+	// Validation requires the complete content
+	if sc, ok1 := codec.(StreamCodec); ok1 && schema == nil {
+		err := sc.Decode(r, v)
+		if err != nil && err != io.EOF {
+			return errors.New(fmt.Sprintf("%v decoding %v", mt, err))
+		}
+		return nil
+	}
+	body, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	if len(body) == 0 {
+		return nil
+	}
+	return unmarshal(contentType, body, v, schema)
+}
+
+// Marshal -  type -> []byte | io.Reader, via the codec registered for the content type, or JSON if there is no
+// registered codec. An io.Reader value is returned as is, and marshalling to an io.Reader is streamed through a pipe.
 func Marshal[T any](ct *Content) (t T, err error) {
 	var buf []byte
 
@@ -105,9 +190,27 @@ func Marshal[T any](ct *Content) (t T, err error) {
 		buf = []byte(ptr)
 	case []byte:
 		buf = ptr
+	case io.Reader:
+		switch p := any(&t).(type) {
+		case *io.Reader:
+			*p = ptr
+			return t, nil
+		case *[]byte:
+			*p, err = io.ReadAll(newLimitReader(ptr, ct.Limit))
+			return t, err
+		}
+		return t, errors.New(fmt.Sprintf("error: generic type: %v is not supported for marshalling an io.Reader", reflect.TypeOf(t)))
 	default:
+		codec, ok := MarshalCodec(ct.Type, false)
+		if !ok {
+			return t, errors.New(fmt.Sprintf("content type: %v is invalid, no codec is registered", ct.Type))
+		}
+		if p, ok1 := any(&t).(*io.Reader); ok1 {
+			*p = encode(codec, ptr)
+			return t, nil
+		}
 		var err1 error
-		buf, err1 = json.Marshal(ptr)
+		buf, err1 = codec.Marshal(ptr)
 		if err1 != nil {
 			return t, err1
 		}
@@ -126,3 +229,68 @@ func Marshal[T any](ct *Content) (t T, err error) {
 	}
 	return t, errors.New(fmt.Sprintf("error: generic type: %v is not supported for marshalling", reflect.TypeOf(t)))
 }
+
+// encode - stream encoding through a pipe, an encoding error is returned by the reader
+func encode(codec Codec, v any) io.Reader {
+	r, w := io.Pipe()
+	go func() {
+		var err error
+		if sc, ok := codec.(StreamCodec); ok {
+			err = sc.Encode(w, v)
+		} else {
+			var buf []byte
+			buf, err = codec.Marshal(v)
+			if err == nil {
+				_, err = w.Write(buf)
+			}
+		}
+		w.CloseWithError(err)
+	}()
+	return r
+}
+
+// limitReader - unlike io.LimitReader, reading past the limit is an error
+type limitReader struct {
+	r     io.Reader
+	limit int64
+	n     int64
+}
+
+func newLimitReader(r io.Reader, limit int64) io.Reader {
+	if limit <= 0 {
+		return r
+	}
+	return &limitReader{r: r, limit: limit}
+}
+
+// newLimitReadCloser - a limit reader that keeps the io.Closer of the reader
+func newLimitReadCloser(r io.Reader, limit int64) io.Reader {
+	lr := newLimitReader(r, limit)
+	if c, ok := r.(io.Closer); ok && lr != r {
+		return struct {
+			io.Reader
+			io.Closer
+		}{lr, c}
+	}
+	return lr
+}
+
+func (l *limitReader) Read(p []byte) (int, error) {
+	if l.n > l.limit {
+		return 0, limitError(l.limit)
+	}
+	// Read one byte past the limit to detect content that is too large
+	if remaining := l.limit - l.n + 1; int64(len(p)) > remaining {
+		p = p[:remaining]
+	}
+	n, err := l.r.Read(p)
+	l.n += int64(n)
+	if l.n > l.limit {
+		return max(n-int(l.n-l.limit), 0), limitError(l.limit)
+	}
+	return n, err
+}
+
+func limitError(limit int64) error {
+	return errors.New(fmt.Sprintf("content exceeds the limit of %v bytes", limit))
+}
@@ -1,10 +1,12 @@
 package core
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
 	"reflect"
+	"strings"
 )
 
 type Address struct {
@@ -136,7 +138,7 @@ func ExampleMap() {
 
 }
 
-func _ExampleReader() {
+func ExampleReader() {
 	//bytes := []byte("this is a test io.Reader")
 	m := map[string]string{
 		"Line1": "123 Main",
@@ -251,3 +253,146 @@ func ExampleNew_Binary() {
 	//test: New[[]byte]() -> this is a test string [type:[]uint8] [status:<nil>]
 
 }
+
+func ExampleUnmarshal_reader() {
+	addr := Address{Line1: "123 Main", City: "Anytown", State: "Ohio", Zip: "54321"}
+	buf, _ := json.Marshal(&addr)
+
+	// io.Reader -> Address, streamed
+	t, status := Unmarshal[Address](&Content{Type: ContentTypeJson, Value: io.NopCloser(bytes.NewReader(buf))})
+	fmt.Printf("test: Unmarshal[Address](io.Reader) -> [%v] [status:%v]\n", t, status)
+
+	// io.Reader -> Address, limit exceeded
+	t, status = Unmarshal[Address](&Content{Type: ContentTypeJson, Value: bytes.NewReader(buf), Limit: 16})
+	fmt.Printf("test: Unmarshal[Address](io.Reader) -> [%v] [status:%v]\n", t, status)
+
+	// io.Reader -> string, within limit
+	t2, status2 := New[string](&Content{Type: ContentTypeText, Value: strings.NewReader("this is a test string"), Limit: 21})
+	fmt.Printf("test: New[string](io.Reader) -> [%v] [status:%v]\n", t2, status2)
+
+	// []byte -> Address, limit exceeded
+	_, status = Unmarshal[Address](&Content{Type: ContentTypeJson, Value: buf, Limit: 16})
+	fmt.Printf("test: Unmarshal[Address]([]byte) -> [status:%v]\n", status)
+
+	//Output:
+	//test: Unmarshal[Address](io.Reader) -> [{123 Main  Anytown Ohio 54321}] [status:<nil>]
+	//test: Unmarshal[Address](io.Reader) -> [{    }] [status:application/json decoding content exceeds the limit of 16 bytes]
+	//test: New[string](io.Reader) -> [this is a test string] [status:<nil>]
+	//test: Unmarshal[Address]([]byte) -> [status:content exceeds the limit of 16 bytes]
+
+}
+
+func ExampleUnmarshal_trailing() {
+	buf := []byte(`{"Line1":"123 Main"} {"Line1":"456 Main"}`)
+
+	_, status := Unmarshal[Address](&Content{Type: ContentTypeJson, Value: buf})
+	fmt.Printf("test: Unmarshal[Address]([]byte) -> [status:%v]\n", status)
+
+	_, status = Unmarshal[Address](&Content{Type: ContentTypeJson, Value: bytes.NewReader(buf)})
+	fmt.Printf("test: Unmarshal[Address](io.Reader) -> [status:%v]\n", status)
+
+	t, status := Unmarshal[Address](&Content{Type: ContentTypeJson, Value: strings.NewReader(`{"Line1":"123 Main"}` + "\n")})
+	fmt.Printf("test: Unmarshal[Address](io.Reader) -> [%v] [status:%v]\n", t.Line1, status)
+
+	//Output:
+	//test: Unmarshal[Address]([]byte) -> [status:application/json unmarshalling invalid character '{' after top-level value]
+	//test: Unmarshal[Address](io.Reader) -> [status:application/json decoding invalid data after top-level value]
+	//test: Unmarshal[Address](io.Reader) -> [123 Main] [status:<nil>]
+
+}
+
+func ExampleUnmarshal_limitReader() {
+	r := newLimitReader(strings.NewReader("this is a test string"), 4)
+	p := make([]byte, 32)
+	for i := 0; i < 3; i++ {
+		n, err := r.Read(p)
+		fmt.Printf("test: Read() -> [n:%v] [err:%v]\n", n, err)
+	}
+
+	//Output:
+	//test: Read() -> [n:4] [err:content exceeds the limit of 4 bytes]
+	//test: Read() -> [n:0] [err:content exceeds the limit of 4 bytes]
+	//test: Read() -> [n:0] [err:content exceeds the limit of 4 bytes]
+
+}
+
+func ExampleContent_SetLimit() {
+	ct := &Content{Type: ContentTypeText, Value: strings.NewReader("this is a test string")}
+	err := ct.SetLimit("16b")
+	fmt.Printf("test: SetLimit(\"16b\") [err:%v] [limit:%v]\n", err, ct.Limit)
+
+	_, status := New[string](ct)
+	fmt.Printf("test: New[string](io.Reader) -> [status:%v]\n", status)
+
+	err = ct.SetLimit("1.5KiB")
+	fmt.Printf("test: SetLimit(\"1.5KiB\") [err:%v] [limit:%v]\n", err, ct.Limit)
+
+	err = ct.SetLimit("10XB")
+	fmt.Printf("test: SetLimit(\"10XB\") [err:%v] [limit:%v]\n", err, ct.Limit)
+
+	//Output:
+	//test: SetLimit("16b") [err:<nil>] [limit:16]
+	//test: New[string](io.Reader) -> [status:content exceeds the limit of 16 bytes]
+	//test: SetLimit("1.5KiB") [err:<nil>] [limit:1536]
+	//test: SetLimit("10XB") [err:byte size unit "XB" is invalid: [10XB]] [limit:1536]
+
+}
+
+func ExampleMarshal_reader() {
+	addr := Address{Line1: "123 Main", City: "Anytown", State: "Ohio", Zip: "54321"}
+
+	// Address -> io.Reader, streamed through a pipe
+	r, status := Marshal[io.Reader](&Content{Type: ContentTypeJson, Value: addr})
+	buf, err := io.ReadAll(r)
+	fmt.Printf("test: Marshal[io.Reader]() -> [%v] [status:%v] [err:%v]\n", string(buf), status, err)
+
+	// encoding error is returned by the reader
+	r, status = Marshal[io.Reader](&Content{Type: ContentTypeJson, Value: make(chan int)})
+	_, err = io.ReadAll(r)
+	fmt.Printf("test: Marshal[io.Reader]() -> [status:%v] [err:%v]\n", status, err)
+
+	// io.Reader -> []byte
+	buf, status = Marshal[[]byte](&Content{Type: ContentTypeJson, Value: strings.NewReader(`{"Line1":"456 Oak"}`)})
+	fmt.Printf("test: Marshal[[]byte](io.Reader) -> [%v] [status:%v]\n", string(buf), status)
+
+	// io.Reader -> string, not supported
+	_, status = Marshal[string](&Content{Type: ContentTypeJson, Value: strings.NewReader(`{"Line1":"456 Oak"}`)})
+	fmt.Printf("test: Marshal[string](io.Reader) -> [status:%v]\n", status)
+
+	//Output:
+	//test: Marshal[io.Reader]() -> [{"Line1":"123 Main","Line2":"","City":"Anytown","State":"Ohio","Zip":"54321"}] [status:<nil>] [err:<nil>]
+	//test: Marshal[io.Reader]() -> [status:<nil>] [err:json: unsupported type: chan int]
+	//test: Marshal[[]byte](io.Reader) -> [{"Line1":"456 Oak"}] [status:<nil>]
+	//test: Marshal[string](io.Reader) -> [status:error: generic type: string is not supported for marshalling an io.Reader]
+
+}
+
+type closeReader struct {
+	io.Reader
+	closed bool
+}
+
+func (c *closeReader) Close() error {
+	c.closed = true
+	return nil
+}
+
+func ExampleUnmarshal_readerClose() {
+	body := &closeReader{Reader: strings.NewReader(`{"City":"Anytown"}`)}
+	r, status := Unmarshal[io.Reader](&Content{Type: ContentTypeJson, Value: body, Limit: 64})
+	buf, _ := io.ReadAll(r)
+	fmt.Printf("test: Unmarshal[io.Reader]() -> [%v] [status:%v] [closed:%v]\n", string(buf), status, body.closed)
+
+	r.(io.Closer).Close()
+	fmt.Printf("test: Close() -> [closed:%v]\n", body.closed)
+
+	body = &closeReader{Reader: strings.NewReader(`{"City":"Anytown"}`)}
+	addr, status := Unmarshal[Address](&Content{Type: ContentTypeJson, Value: body})
+	fmt.Printf("test: Unmarshal[Address]() -> [%v] [status:%v] [closed:%v]\n", addr.City, status, body.closed)
+
+	//Output:
+	//test: Unmarshal[io.Reader]() -> [{"City":"Anytown"}] [status:<nil>] [closed:false]
+	//test: Close() -> [closed:true]
+	//test: Unmarshal[Address]() -> [Anytown] [status:<nil>] [closed:true]
+
+}
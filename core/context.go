@@ -2,9 +2,15 @@ package core
 
 import (
 	"context"
+	"net/http"
+	"strconv"
 	"time"
 )
 
+const (
+	XRequestTimeout = "X-Request-Timeout"
+)
+
 var (
 	cancelFn = func() {}
 )
@@ -18,3 +24,30 @@ func NewContext(ctx context.Context, timeout time.Duration) (context.Context, fu
 	}
 	return context.WithTimeout(ctx, timeout)
 }
+
+// RequestTimeout - the X-Request-Timeout header budget, in milliseconds
+func RequestTimeout(h http.Header) (time.Duration, bool) {
+	s := h.Get(XRequestTimeout)
+	if s == "" {
+		return 0, false
+	}
+	ms, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || ms < 0 {
+		return 0, false
+	}
+	return time.Duration(ms) * time.Millisecond, true
+}
+
+// NewRequestContext - create a context bounded by the request X-Request-Timeout header budget and the local
+// timeout, whichever is less. An exhausted budget of 0 creates a context that is already done.
+func NewRequestContext(r *http.Request, timeout time.Duration) (context.Context, func()) {
+	if budget, ok := RequestTimeout(r.Header); ok {
+		if budget == 0 {
+			return context.WithDeadline(r.Context(), time.Now())
+		}
+		if timeout <= 0 || budget < timeout {
+			timeout = budget
+		}
+	}
+	return NewContext(r.Context(), timeout)
+}
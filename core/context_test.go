@@ -1,5 +1,44 @@
 package core
 
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+)
+
 func ExampleNewContext() {
 
 }
+
+func ExampleNewRequestContext() {
+	req := httptest.NewRequest(http.MethodGet, "/search", nil)
+	ctx, cancel := NewRequestContext(req, time.Second)
+	d, _ := ctx.Deadline()
+	fmt.Printf("test: NewRequestContext() -> [local:%v]\n", time.Until(d) > time.Millisecond*900)
+	cancel()
+
+	req.Header.Set(XRequestTimeout, "200")
+	ctx, cancel = NewRequestContext(req, time.Second)
+	d, _ = ctx.Deadline()
+	fmt.Printf("test: NewRequestContext(\"200\") -> [budget:%v]\n", time.Until(d) <= time.Millisecond*200)
+	cancel()
+
+	req.Header.Set(XRequestTimeout, "0")
+	ctx, cancel = NewRequestContext(req, time.Second)
+	fmt.Printf("test: NewRequestContext(\"0\") -> [err:%v]\n", ctx.Err())
+	cancel()
+
+	req.Header.Set(XRequestTimeout, "invalid")
+	ctx, cancel = NewRequestContext(req, 0)
+	_, ok := ctx.Deadline()
+	fmt.Printf("test: NewRequestContext(\"invalid\") -> [deadline:%v]\n", ok)
+	cancel()
+
+	//Output:
+	//test: NewRequestContext() -> [local:true]
+	//test: NewRequestContext("200") -> [budget:true]
+	//test: NewRequestContext("0") -> [err:context deadline exceeded]
+	//test: NewRequestContext("invalid") -> [deadline:false]
+
+}
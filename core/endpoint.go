@@ -1,29 +1,92 @@
 package core
 
-import "net/http"
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"runtime/debug"
+	"strings"
+	"time"
+)
+
+const (
+	contentTypeHeader = "Content-Type"
+	allowHeader       = "Allow"
+)
+
+var (
+	errPanic = errors.New("internal server error")
+)
 
 // HttpHandler - extend the http.HandlerFunc to include the http.Response
 type HttpHandler func(w http.ResponseWriter, req *http.Request, resp *http.Response)
 type HttpInit func(r *http.Request) *http.Request
 
+// HttpErrorHandler - handle a network error, the response may be nil
+type HttpErrorHandler func(w http.ResponseWriter, req *http.Request, resp *http.Response, err error)
+
 type Endpoint interface {
 	Pattern() string
 	ServeHTTP(w http.ResponseWriter, r *http.Request)
 }
 
+// EndpointConfig - endpoint configuration. A nil ErrorHandler uses DefaultErrorHandler, and an empty Methods
+// list allows all methods. The request context is bounded by the Timeout and the X-Request-Timeout header.
+type EndpointConfig struct {
+	Pattern      string
+	Handler      HttpHandler
+	ErrorHandler HttpErrorHandler
+	Init         HttpInit
+	Methods      []string
+	Timeout      time.Duration
+	Operatives   []any
+}
+
 type endpoint struct {
-	pattern string
-	handler HttpHandler
-	init    HttpInit
-	chain   Exchange
+	pattern      string
+	handler      HttpHandler
+	errorHandler HttpErrorHandler
+	init         HttpInit
+	methods      []string
+	timeout      time.Duration
+	network      *Network
+	chain        Exchange
 }
 
+// NewEndpoint - create an endpoint, panic on a nil or invalid type operative
 func NewEndpoint(pattern string, handler HttpHandler, init HttpInit, operatives []any) Endpoint {
+	n, err := NewNetwork(operatives)
+	if err != nil {
+		panic(err.Error())
+	}
+	return newEndpoint(EndpointConfig{Pattern: pattern, Handler: handler, Init: init}, n)
+}
+
+// NewEndpointWithConfig - create an endpoint, returning an error on a nil or invalid type operative
+func NewEndpointWithConfig(cfg EndpointConfig) (Endpoint, error) {
+	n, err := NewNetwork(cfg.Operatives)
+	if err != nil {
+		return nil, err
+	}
+	return newEndpoint(cfg, n), nil
+}
+
+func newEndpoint(cfg EndpointConfig, n *Network) *endpoint {
 	e := new(endpoint)
-	e.pattern = pattern
-	e.handler = handler
-	e.init = init
-	e.chain = BuildNetwork(operatives)
+	e.pattern = cfg.Pattern
+	e.handler = cfg.Handler
+	e.errorHandler = cfg.ErrorHandler
+	if e.errorHandler == nil {
+		e.errorHandler = DefaultErrorHandler
+	}
+	e.init = cfg.Init
+	e.timeout = cfg.Timeout
+	for _, m := range cfg.Methods {
+		e.methods = append(e.methods, strings.ToUpper(m))
+	}
+	e.network = n
+	e.chain = n.Exchange()
 	return e
 }
 
@@ -31,14 +94,113 @@ func (e *endpoint) Pattern() string {
 	return e.pattern
 }
 
-func (e *endpoint) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+// Links - network links in order
+func (e *endpoint) Links() []Link {
+	return e.network.Links()
+}
+
+// ServeHTTP - a panic is recovered into a 500 response with a generic error, and the panic value and stack are
+// only logged. If the response header has already been written, the 500 response is not sent.
+func (e *endpoint) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	w := &headerWriter{ResponseWriter: rw}
+	defer func() {
+		if v := recover(); v != nil {
+			if v == http.ErrAbortHandler {
+				panic(v)
+			}
+			log.Printf("endpoint %v panic: %v\n%s", e.pattern, v, debug.Stack())
+			if !w.written {
+				e.errorHandler(w, r, nil, NewStatus(http.StatusInternalServerError, errPanic))
+			}
+		}
+	}()
 	if e.handler == nil || e.chain == nil {
 		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
+	if !e.allowed(r.Method) {
+		w.Header().Set(allowHeader, strings.Join(e.methods, ", "))
+		e.errorHandler(w, r, nil, NewStatus(http.StatusMethodNotAllowed, errors.New(fmt.Sprintf("method %v is not allowed", r.Method))))
+		return
+	}
+	ctx, cancel := NewRequestContext(r, e.timeout)
+	defer cancel()
+	if ctx != r.Context() {
+		r = r.WithContext(ctx)
+	}
 	if e.init != nil {
 		r = e.init(r)
 	}
-	resp, _ := e.chain(r)
+	resp, err := e.chain(r)
+	if err != nil {
+		e.errorHandler(w, r, resp, Location(err, e.pattern))
+		return
+	}
 	e.handler(w, r, resp)
 }
+
+func (e *endpoint) allowed(method string) bool {
+	if len(e.methods) == 0 {
+		return true
+	}
+	for _, m := range e.methods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+// DefaultErrorHandler - write an application/problem+json response. The status is from a *Status in the error
+// chain, otherwise the response status code if it is an error, or 500. The response body is closed, as the
+// problem replaces it.
+func DefaultErrorHandler(w http.ResponseWriter, req *http.Request, resp *http.Response, err error) {
+	if resp != nil && resp.Body != nil {
+		resp.Body.Close()
+	}
+	var s *Status
+	if !errors.As(err, &s) {
+		code := http.StatusInternalServerError
+		if resp != nil && resp.StatusCode >= http.StatusBadRequest {
+			code = resp.StatusCode
+		}
+		s = NewStatus(code, err)
+	}
+	buf, err1 := s.MarshalProblem()
+	if err1 != nil {
+		w.WriteHeader(HttpCode(s.Code))
+		return
+	}
+	w.Header().Set(contentTypeHeader, ContentTypeProblemJson)
+	w.WriteHeader(HttpCode(s.Code))
+	w.Write(buf)
+}
+
+// headerWriter - records if the response header has been written
+type headerWriter struct {
+	http.ResponseWriter
+	written bool
+}
+
+func (w *headerWriter) WriteHeader(code int) {
+	w.written = true
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *headerWriter) Write(buf []byte) (int, error) {
+	w.written = true
+	return w.ResponseWriter.Write(buf)
+}
+
+// Flush - http.Flusher support
+func (w *headerWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		w.written = true
+		f.Flush()
+	}
+}
+
+// Unwrap - http.ResponseController support
+func (w *headerWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
@@ -1,8 +1,15 @@
 package core
 
 import (
+	"errors"
 	"fmt"
+	"io"
+	"log"
 	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"time"
 )
 
 func _ExampleNewEndpoint() {
@@ -15,3 +22,126 @@ func _ExampleNewEndpoint() {
 	//test: NewEndpoint() -> [&{/resource/test <nil> <nil> <nil>}] [/resource/test] [ServeHTTP:true]
 
 }
+
+func ExampleNewEndpointWithConfig() {
+	handler := func(w http.ResponseWriter, req *http.Request, resp *http.Response) {
+		w.WriteHeader(resp.StatusCode)
+	}
+	fail := func(next Exchange) Exchange {
+		return func(r *http.Request) (*http.Response, error) {
+			if r.URL.Query().Get("fail") == "true" {
+				return &http.Response{StatusCode: http.StatusBadGateway}, NewStatus(StatusUnavailable, errors.New("upstream is unavailable"))
+			}
+			return &http.Response{StatusCode: http.StatusOK}, nil
+		}
+	}
+	e, err := NewEndpointWithConfig(EndpointConfig{Pattern: "/search", Handler: handler, Methods: []string{http.MethodGet, http.MethodHead}, Operatives: []any{fail}})
+	fmt.Printf("test: NewEndpointWithConfig() -> [%v] [err:%v]\n", e.Pattern(), err)
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/search", nil))
+	fmt.Printf("test: ServeHTTP(GET) -> [status:%v]\n", rec.Code)
+
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/search?fail=true", nil))
+	fmt.Printf("test: ServeHTTP(GET fail) -> [status:%v] [content-type:%v] %v\n", rec.Code, rec.Header().Get("Content-Type"), rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/search", nil))
+	fmt.Printf("test: ServeHTTP(POST) -> [status:%v] [allow:%v]\n", rec.Code, rec.Header().Get("Allow"))
+
+	_, err = NewEndpointWithConfig(EndpointConfig{Pattern: "/search", Handler: handler})
+	fmt.Printf("test: NewEndpointWithConfig() -> [err:%v]\n", err)
+
+	//Output:
+	//test: NewEndpointWithConfig() -> [/search] [err:<nil>]
+	//test: ServeHTTP(GET) -> [status:200]
+	//test: ServeHTTP(GET fail) -> [status:503] [content-type:application/problem+json] {"code":14,"status":503,"title":"Unavailable","trace":["/search"],"type":"about:blank"}
+	//test: ServeHTTP(POST) -> [status:405] [allow:GET, HEAD]
+	//test: NewEndpointWithConfig() -> [err:operatives list is nil]
+
+}
+
+func ExampleNewEndpointWithConfig_panic() {
+	handler := func(w http.ResponseWriter, req *http.Request, resp *http.Response) {
+		w.WriteHeader(resp.StatusCode)
+	}
+	errorHandler := func(w http.ResponseWriter, req *http.Request, resp *http.Response, err error) {
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Printf("test: ErrorHandler() -> [err:%v]\n", err)
+	}
+	panicFn := func(next Exchange) Exchange {
+		return func(r *http.Request) (*http.Response, error) {
+			var m map[string]string
+			m["key"] = "value"
+			return nil, nil
+		}
+	}
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(os.Stderr)
+	e, _ := NewEndpointWithConfig(EndpointConfig{Pattern: "/search", Handler: handler, ErrorHandler: errorHandler, Operatives: []any{panicFn}})
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/search", nil))
+	fmt.Printf("test: ServeHTTP() -> [status:%v]\n", rec.Code)
+
+	e, _ = NewEndpointWithConfig(EndpointConfig{Pattern: "/search", Handler: handler, Operatives: []any{panicFn}})
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/search", nil))
+	fmt.Printf("test: ServeHTTP() -> [status:%v] %v\n", rec.Code, rec.Body.String())
+
+	// The header has been written, so the 500 response is not sent
+	written := func(w http.ResponseWriter, req *http.Request, resp *http.Response) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("partial"))
+		panic("handler failure")
+	}
+	e, _ = NewEndpointWithConfig(EndpointConfig{Pattern: "/search", Handler: written, ErrorHandler: errorHandler, Operatives: []any{do1ExchangeFn}})
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/search", nil))
+	fmt.Printf("test: ServeHTTP() -> [status:%v] %v\n", rec.Code, rec.Body.String())
+
+	//Output:
+	//test: ErrorHandler() -> [err:Internal Error - internal server error]
+	//test: ServeHTTP() -> [status:500]
+	//test: ServeHTTP() -> [status:500] {"status":500,"title":"Internal Error","type":"about:blank"}
+	//test: Do1-Exchange() -> request
+	//test: Do1-Exchange() -> response
+	//test: ServeHTTP() -> [status:200] partial
+
+}
+
+func ExampleNewEndpointWithConfig_timeout() {
+	handler := func(w http.ResponseWriter, req *http.Request, resp *http.Response) {
+		w.WriteHeader(resp.StatusCode)
+	}
+	deadline := func(next Exchange) Exchange {
+		return func(r *http.Request) (*http.Response, error) {
+			d, ok := r.Context().Deadline()
+			fmt.Printf("test: Exchange() -> [deadline:%v] [budget:%v]\n", ok, ok && time.Until(d) <= time.Millisecond*100)
+			return &http.Response{StatusCode: http.StatusOK}, nil
+		}
+	}
+	e, _ := NewEndpointWithConfig(EndpointConfig{Pattern: "/search", Handler: handler, Timeout: time.Second, Operatives: []any{deadline}})
+
+	e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/search", nil))
+	req := httptest.NewRequest(http.MethodGet, "/search", nil)
+	req.Header.Set(XRequestTimeout, "100")
+	e.ServeHTTP(httptest.NewRecorder(), req)
+
+	//Output:
+	//test: Exchange() -> [deadline:true] [budget:false]
+	//test: Exchange() -> [deadline:true] [budget:true]
+
+}
+
+func ExampleDefaultErrorHandler() {
+	body := &closeReader{Reader: strings.NewReader("upstream error")}
+	rec := httptest.NewRecorder()
+	DefaultErrorHandler(rec, httptest.NewRequest(http.MethodGet, "/search", nil), &http.Response{StatusCode: http.StatusBadGateway, Body: body}, errors.New("bad gateway"))
+	fmt.Printf("test: DefaultErrorHandler() -> [status:%v] [closed:%v] %v\n", rec.Code, body.closed, rec.Body.String())
+
+	//Output:
+	//test: DefaultErrorHandler() -> [status:502] [closed:true] {"status":502,"title":"Bad Gateway","type":"about:blank"}
+
+}
@@ -0,0 +1,250 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"runtime/debug"
+)
+
+// FanOutStrategy - how fan-out responses are selected
+type FanOutStrategy int
+
+const (
+	FanOutFirstSuccess FanOutStrategy = iota // First successful response, remaining calls are cancelled
+	FanOutAll                                // All responses must be successful
+	FanOutQuorum                             // A quorum of responses must be successful, remaining calls are cancelled
+)
+
+// String - strategy name
+func (s FanOutStrategy) String() string {
+	switch s {
+	case FanOutFirstSuccess:
+		return "first-success"
+	case FanOutAll:
+		return "all"
+	case FanOutQuorum:
+		return "quorum"
+	}
+	return fmt.Sprintf("FanOutStrategy(%v)", int(s))
+}
+
+// MergeFunc - merge successful fan-out responses, in sub-network order. The merge function must close the body
+// of every response that it does not return, as the sub-call of a response is only cancelled when its body is
+// closed.
+type MergeFunc func(r *http.Request, responses []*http.Response) (*http.Response, error)
+
+// FanOut - a Chainable[Exchange] that calls sub-networks concurrently. A fan-out is a terminal link, and
+// the next link is not called.
+type FanOut struct {
+	strategy FanOutStrategy
+	quorum   int
+	merge    MergeFunc
+	networks []Exchange
+}
+
+type fanOutResult struct {
+	index int
+	resp  *http.Response
+	err   error
+}
+
+// NewFanOut - create a fan-out of sub-networks. The quorum is only used by the FanOutQuorum strategy, and a nil
+// merge function selects the first response in sub-network order.
+func NewFanOut(strategy FanOutStrategy, quorum int, merge MergeFunc, networks ...[]any) (*FanOut, error) {
+	if len(networks) == 0 {
+		return nil, errors.New("fan-out networks list is empty")
+	}
+	switch strategy {
+	case FanOutFirstSuccess, FanOutAll:
+	case FanOutQuorum:
+		if quorum < 1 || quorum > len(networks) {
+			return nil, errors.New(fmt.Sprintf("fan-out quorum %v is invalid for %v networks", quorum, len(networks)))
+		}
+	default:
+		return nil, errors.New(fmt.Sprintf("fan-out strategy is invalid: %v", strategy))
+	}
+	f := new(FanOut)
+	f.strategy = strategy
+	f.quorum = quorum
+	f.merge = merge
+	if f.merge == nil {
+		f.merge = firstResponse
+	}
+	for i, ops := range networks {
+		head, _, err := linkNetwork[Exchange, Chainable[Exchange]](ops)
+		if err != nil {
+			return nil, errors.New(fmt.Sprintf("fan-out network %v: %v", i, err))
+		}
+		f.networks = append(f.networks, head)
+	}
+	return f, nil
+}
+
+// Name - network link name
+func (f *FanOut) Name() string {
+	if f.strategy == FanOutQuorum {
+		return fmt.Sprintf("fan-out(%v %v/%v)", f.strategy, f.quorum, len(f.networks))
+	}
+	return fmt.Sprintf("fan-out(%v %v)", f.strategy, len(f.networks))
+}
+
+// Link - the next link is not called
+func (f *FanOut) Link(next Exchange) Exchange {
+	return f.exchange
+}
+
+func (f *FanOut) exchange(r *http.Request) (*http.Response, error) {
+	var body []byte
+	if r.Body != nil && r.Body != http.NoBody {
+		buf, err := io.ReadAll(r.Body)
+		r.Body.Close()
+		if err != nil {
+			return &http.Response{StatusCode: http.StatusInternalServerError}, err
+		}
+		body = buf
+	}
+	required := len(f.networks)
+	switch f.strategy {
+	case FanOutFirstSuccess:
+		required = 1
+	case FanOutQuorum:
+		required = f.quorum
+	}
+
+	cancels := make([]context.CancelFunc, len(f.networks))
+	results := make(chan fanOutResult, len(f.networks))
+	for i, ex := range f.networks {
+		ctx, cancel := context.WithCancel(r.Context())
+		cancels[i] = cancel
+		req := r.Clone(ctx)
+		if body != nil {
+			req.Body = io.NopCloser(bytes.NewReader(body))
+			req.ContentLength = int64(len(body))
+		}
+		go func(i int, ex Exchange, req *http.Request) {
+			// A sub-network panic is returned as a failure, as the panic cannot be recovered by the caller
+			defer func() {
+				if v := recover(); v != nil {
+					log.Printf("fan-out network %v panic: %v\n%s", i, v, debug.Stack())
+					err := NewStatus(http.StatusInternalServerError, errors.New(fmt.Sprintf("fan-out network %v panic: %v", i, v)))
+					results <- fanOutResult{index: i, resp: &http.Response{StatusCode: http.StatusInternalServerError}, err: err}
+				}
+			}()
+			resp, err := ex(req)
+			results <- fanOutResult{index: i, resp: resp, err: err}
+		}(i, ex, req)
+	}
+
+	success := make([]*http.Response, len(f.networks))
+	var failures []fanOutResult
+	received := make([]bool, len(f.networks))
+	successes := 0
+	for range f.networks {
+		result := <-results
+		received[result.index] = true
+		if fanOutSuccess(result) {
+			success[result.index] = result.resp
+			successes++
+		} else {
+			failures = append(failures, result)
+		}
+		if successes == required || len(f.networks)-len(failures) < required {
+			break
+		}
+	}
+	// The outcome is decided, so cancel and drain the remaining calls
+	pending := 0
+	for i := range received {
+		if !received[i] {
+			cancels[i]()
+			pending++
+		}
+	}
+	go func(n int) {
+		for ; n > 0; n-- {
+			closeBody((<-results).resp)
+		}
+	}(pending)
+
+	if successes < required {
+		for i, resp := range success {
+			if resp != nil {
+				closeBody(resp)
+				cancels[i]()
+			}
+		}
+		// Return the failure of the first sub-network, the other failures are closed
+		failure := failures[0]
+		for _, result := range failures[1:] {
+			if result.index < failure.index {
+				failure = result
+			}
+		}
+		for _, result := range failures {
+			if result.index != failure.index {
+				closeBody(result.resp)
+				cancels[result.index]()
+			}
+		}
+		if failure.resp == nil {
+			failure.resp = &http.Response{StatusCode: http.StatusInternalServerError}
+		}
+		cancelOnClose(failure.resp, cancels[failure.index])
+		return failure.resp, failure.err
+	}
+	for _, result := range failures {
+		closeBody(result.resp)
+		cancels[result.index]()
+	}
+	// Successful calls are cancelled when the response body is closed, as the body may still be read
+	var list []*http.Response
+	for i, resp := range success {
+		if resp != nil {
+			cancelOnClose(resp, cancels[i])
+			list = append(list, resp)
+		}
+	}
+	return f.merge(r, list)
+}
+
+func fanOutSuccess(result fanOutResult) bool {
+	return result.err == nil && result.resp != nil && result.resp.StatusCode < http.StatusBadRequest
+}
+
+func firstResponse(_ *http.Request, responses []*http.Response) (*http.Response, error) {
+	for _, resp := range responses[1:] {
+		closeBody(resp)
+	}
+	return responses[0], nil
+}
+
+// cancelOnClose - cancel a sub-call context when the response body is closed, or now if there is no body
+func cancelOnClose(resp *http.Response, cancel context.CancelFunc) {
+	if resp.Body == nil || resp.Body == http.NoBody {
+		cancel()
+		return
+	}
+	resp.Body = &cancelBody{ReadCloser: resp.Body, cancel: cancel}
+}
+
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+func closeBody(resp *http.Response) {
+	if resp != nil && resp.Body != nil {
+		resp.Body.Close()
+	}
+}
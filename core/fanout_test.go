@@ -0,0 +1,159 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+func fanOutExchangeFn(name string, code int, delay time.Duration) func(next Exchange) Exchange {
+	return func(next Exchange) Exchange {
+		return func(req *http.Request) (*http.Response, error) {
+			select {
+			case <-time.After(delay):
+			case <-req.Context().Done():
+				return &http.Response{StatusCode: http.StatusGatewayTimeout}, req.Context().Err()
+			}
+			return &http.Response{StatusCode: code, Header: http.Header{"X-Name": {name}}}, nil
+		}
+	}
+}
+
+func mergeNames(_ *http.Request, responses []*http.Response) (*http.Response, error) {
+	var names []string
+	for _, resp := range responses {
+		names = append(names, resp.Header.Get("X-Name"))
+	}
+	return &http.Response{StatusCode: http.StatusOK, Header: http.Header{"X-Name": {strings.Join(names, ",")}}}, nil
+}
+
+func ExampleNewFanOut() {
+	req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://localhost/search", nil)
+	f, err := NewFanOut(FanOutFirstSuccess, 0, nil,
+		[]any{fanOutExchangeFn("slow", http.StatusOK, time.Second)},
+		[]any{fanOutExchangeFn("fail", http.StatusServiceUnavailable, 0)},
+		[]any{fanOutExchangeFn("fast", http.StatusOK, time.Millisecond*10)},
+	)
+	fmt.Printf("test: NewFanOut() -> %v [err:%v]\n", f.Name(), err)
+	resp, err := BuildNetwork([]any{f})(req)
+	fmt.Printf("test: Exchange() -> [status:%v] [name:%v] [err:%v]\n", resp.StatusCode, resp.Header.Get("X-Name"), err)
+
+	f, _ = NewFanOut(FanOutAll, 0, mergeNames,
+		[]any{fanOutExchangeFn("one", http.StatusOK, time.Millisecond*10)},
+		[]any{fanOutExchangeFn("two", http.StatusOK, 0)},
+	)
+	resp, err = BuildNetwork([]any{f})(req)
+	fmt.Printf("test: Exchange() -> [status:%v] [name:%v] [err:%v]\n", resp.StatusCode, resp.Header.Get("X-Name"), err)
+
+	f, _ = NewFanOut(FanOutAll, 0, mergeNames,
+		[]any{fanOutExchangeFn("one", http.StatusOK, 0)},
+		[]any{fanOutExchangeFn("two", http.StatusBadGateway, 0)},
+	)
+	resp, err = BuildNetwork([]any{f})(req)
+	fmt.Printf("test: Exchange() -> [status:%v] [name:%v] [err:%v]\n", resp.StatusCode, resp.Header.Get("X-Name"), err)
+
+	//Output:
+	//test: NewFanOut() -> fan-out(first-success 3) [err:<nil>]
+	//test: Exchange() -> [status:200] [name:fast] [err:<nil>]
+	//test: Exchange() -> [status:200] [name:one,two] [err:<nil>]
+	//test: Exchange() -> [status:502] [name:two] [err:<nil>]
+
+}
+
+func ExampleNewFanOut_quorum() {
+	req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://localhost/search", nil)
+	f, _ := NewFanOut(FanOutQuorum, 2, mergeNames,
+		[]any{fanOutExchangeFn("one", http.StatusOK, time.Millisecond*10)},
+		[]any{fanOutExchangeFn("two", http.StatusOK, time.Second)},
+		[]any{fanOutExchangeFn("three", http.StatusOK, 0)},
+	)
+	fmt.Printf("test: NewFanOut() -> %v\n", f.Name())
+	resp, err := BuildNetwork([]any{f})(req)
+	fmt.Printf("test: Exchange() -> [status:%v] [name:%v] [err:%v]\n", resp.StatusCode, resp.Header.Get("X-Name"), err)
+
+	f, _ = NewFanOut(FanOutQuorum, 2, mergeNames,
+		[]any{fanOutExchangeFn("one", http.StatusInternalServerError, 0)},
+		[]any{fanOutExchangeFn("two", http.StatusOK, time.Second)},
+		[]any{func(next Exchange) Exchange {
+			return func(r *http.Request) (*http.Response, error) {
+				return nil, errors.New("connection refused")
+			}
+		}},
+	)
+	resp, err = BuildNetwork([]any{f})(req)
+	fmt.Printf("test: Exchange() -> [status:%v] [err:%v]\n", resp.StatusCode, err)
+
+	_, err = NewFanOut(FanOutQuorum, 4, nil, []any{do1ExchangeFn})
+	fmt.Printf("test: NewFanOut() -> [err:%v]\n", err)
+
+	//Output:
+	//test: NewFanOut() -> fan-out(quorum 2/3)
+	//test: Exchange() -> [status:200] [name:one,three] [err:<nil>]
+	//test: Exchange() -> [status:500] [err:<nil>]
+	//test: NewFanOut() -> [err:fan-out quorum 4 is invalid for 1 networks]
+
+}
+
+func ExampleNewFanOut_body() {
+	var contexts []context.Context
+	bodyExchangeFn := func(code int, body string) func(next Exchange) Exchange {
+		return func(next Exchange) Exchange {
+			return func(req *http.Request) (*http.Response, error) {
+				contexts = append(contexts, req.Context())
+				return &http.Response{StatusCode: code, Body: io.NopCloser(strings.NewReader(body))}, nil
+			}
+		}
+	}
+	req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://localhost/search", nil)
+
+	// Failure body is returned open, and the sub-call context is cancelled when the body is closed
+	f, _ := NewFanOut(FanOutFirstSuccess, 0, nil, []any{bodyExchangeFn(http.StatusBadGateway, "upstream failed")})
+	resp, _ := BuildNetwork([]any{f})(req)
+	buf, err := io.ReadAll(resp.Body)
+	fmt.Printf("test: Exchange() -> [status:%v] [body:%v] [err:%v] [ctx:%v]\n", resp.StatusCode, string(buf), err, contexts[0].Err())
+	resp.Body.Close()
+	fmt.Printf("test: Close() -> [ctx:%v]\n", contexts[0].Err())
+
+	// Successful body is returned open, and the sub-call context is cancelled when the body is closed
+	contexts = nil
+	f, _ = NewFanOut(FanOutFirstSuccess, 0, nil, []any{bodyExchangeFn(http.StatusOK, "search results")})
+	resp, _ = BuildNetwork([]any{f})(req)
+	buf, err = io.ReadAll(resp.Body)
+	fmt.Printf("test: Exchange() -> [status:%v] [body:%v] [err:%v] [ctx:%v]\n", resp.StatusCode, string(buf), err, contexts[0].Err())
+	resp.Body.Close()
+	fmt.Printf("test: Close() -> [ctx:%v]\n", contexts[0].Err())
+
+	//Output:
+	//test: Exchange() -> [status:502] [body:upstream failed] [err:<nil>] [ctx:<nil>]
+	//test: Close() -> [ctx:context canceled]
+	//test: Exchange() -> [status:200] [body:search results] [err:<nil>] [ctx:<nil>]
+	//test: Close() -> [ctx:context canceled]
+
+}
+
+func ExampleNewFanOut_panic() {
+	req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://localhost/search", nil)
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	f, _ := NewFanOut(FanOutAll, 0, mergeNames,
+		[]any{fanOutExchangeFn("one", http.StatusOK, 0)},
+		[]any{func(next Exchange) Exchange {
+			return func(r *http.Request) (*http.Response, error) {
+				panic("sub-network failure")
+			}
+		}},
+	)
+	resp, err := BuildNetwork([]any{f})(req)
+	fmt.Printf("test: Exchange() -> [status:%v] [err:%v]\n", resp.StatusCode, err)
+
+	//Output:
+	//test: Exchange() -> [status:500] [err:Internal Error - fan-out network 1 panic: sub-network failure]
+
+}
@@ -5,23 +5,110 @@ import (
 	"net/http"
 )
 
-// HttpCode - conversion of a code to HTTP status code
+const (
+	httpClientClosedRequest = 499 // Non-standard, used for a request cancelled by the client
+)
+
+// HttpCode - conversion of a code to HTTP status code. A code of 0, the zero value, is an error and not
+// StatusGrpcOK, use GrpcHttpCode to convert a gRPC canonical code.
 func HttpCode(code int) int {
-	// Catch all valid httpx status codes
-	if code >= http.StatusContinue {
-		return code
-	}
-	// map known
+	// map known, before the HTTP range check as some codes overlap 1xx
 	switch code {
-	case StatusInvalidArgument:
-		return http.StatusInternalServerError
+	case StatusCancelled:
+		return httpClientClosedRequest
+	case StatusInvalidContent:
+		return http.StatusUnprocessableEntity
+	case StatusInvalidArgument, StatusFailedPrecondition, StatusOutOfRange:
+		return http.StatusBadRequest
 	case StatusDeadlineExceeded:
 		return http.StatusGatewayTimeout
+	case StatusGrpcNotFound:
+		return http.StatusNotFound
+	case StatusAlreadyExists, StatusAborted:
+		return http.StatusConflict
+	case StatusPermissionDenied:
+		return http.StatusForbidden
+	case StatusResourceExhausted, StatusRateLimited:
+		return http.StatusTooManyRequests
+	case StatusUnimplemented:
+		return http.StatusNotImplemented
+	case StatusUnavailable:
+		return http.StatusServiceUnavailable
+	case StatusUnauthenticated:
+		return http.StatusUnauthorized
+	case StatusUnknown, StatusInternal, StatusDataLoss, StatusGzipEncodingError, StatusGzipDecodingError, StatusExecError:
+		return http.StatusInternalServerError
+	}
+	// Catch all valid httpx status codes
+	if code >= http.StatusContinue {
+		return code
 	}
 	// all others
 	return http.StatusInternalServerError
 }
 
+// GrpcHttpCode - conversion of a gRPC canonical code to HTTP status code, StatusGrpcOK is 200
+func GrpcHttpCode(code int) int {
+	if code == StatusGrpcOK {
+		return http.StatusOK
+	}
+	return HttpCode(code)
+}
+
+// GrpcCode - conversion of a code to a gRPC canonical code. gRPC canonical codes are returned as is, and
+// other codes that are not mapped are StatusUnknown.
+func GrpcCode(code int) int {
+	// map custom codes, before the HTTP range check as some codes overlap 1xx
+	switch code {
+	case StatusInvalidContent:
+		return StatusInvalidArgument
+	case StatusRateLimited:
+		return StatusResourceExhausted
+	case StatusIOError, StatusJsonDecodeError, StatusJsonEncodeError, StatusContentEncodingError, StatusContentEncodingInvalidType,
+		StatusGzipEncodingError, StatusGzipDecodingError, StatusExecError:
+		return StatusInternal
+	case StatusNotProvided, StatusNotStarted, StatusHaveContent:
+		return StatusUnknown
+	}
+	if code >= StatusGrpcOK && code <= StatusUnauthenticated {
+		return code
+	}
+	switch code {
+	case http.StatusBadRequest:
+		return StatusInvalidArgument
+	case http.StatusUnauthorized:
+		return StatusUnauthenticated
+	case http.StatusForbidden:
+		return StatusPermissionDenied
+	case http.StatusNotFound:
+		return StatusGrpcNotFound
+	case http.StatusRequestTimeout, http.StatusGatewayTimeout:
+		return StatusDeadlineExceeded
+	case http.StatusConflict:
+		return StatusAlreadyExists
+	case http.StatusPreconditionFailed:
+		return StatusFailedPrecondition
+	case http.StatusUnprocessableEntity:
+		return StatusInvalidArgument
+	case http.StatusRequestedRangeNotSatisfiable:
+		return StatusOutOfRange
+	case http.StatusTooManyRequests:
+		return StatusResourceExhausted
+	case httpClientClosedRequest:
+		return StatusCancelled
+	case http.StatusInternalServerError:
+		return StatusInternal
+	case http.StatusNotImplemented:
+		return StatusUnimplemented
+	case http.StatusBadGateway, http.StatusServiceUnavailable:
+		return StatusUnavailable
+	}
+	if code >= http.StatusOK && code < http.StatusMultipleChoices {
+		return StatusGrpcOK
+	}
+	return StatusUnknown
+}
+
 // HttpStatus - string representation of status code
 func HttpStatus(code int) string {
 	switch code {
@@ -57,8 +144,37 @@ func HttpStatus(code int) string {
 	case StatusExecError:
 		return "Execution Error"
 
-		//case StatusUnavailable:
-		//	return "Invalid Argument"
+		// gRPC
+	case StatusGrpcOK:
+		return "OK"
+	case StatusCancelled:
+		return "Cancelled"
+	case StatusUnknown:
+		return "Unknown Error"
+	case StatusGrpcNotFound:
+		return "Not Found"
+	case StatusAlreadyExists:
+		return "Already Exists"
+	case StatusPermissionDenied:
+		return "Permission Denied"
+	case StatusResourceExhausted:
+		return "Resource Exhausted"
+	case StatusFailedPrecondition:
+		return "Failed Precondition"
+	case StatusAborted:
+		return "Aborted"
+	case StatusOutOfRange:
+		return "Out Of Range"
+	case StatusUnimplemented:
+		return "Unimplemented"
+	case StatusInternal:
+		return "Internal Error"
+	case StatusUnavailable:
+		return "Unavailable"
+	case StatusDataLoss:
+		return "Data Loss"
+	case StatusUnauthenticated:
+		return "Unauthenticated"
 
 		//Http
 	case http.StatusOK:
@@ -85,28 +201,24 @@ func HttpStatus(code int) string {
 		return "Service Unavailable"
 	case http.StatusUnauthorized:
 		return "Unauthorized"
-
-		// Unmapped
-		/*
-			case StatusCancelled:
-				return "The operation was cancelled, typically by the caller"
-			case StatusUnknown:
-				return "Unknown error" // For example, this error may be returned when a Status value received from another address space belongs to an error space that is not known in this address space. Also errors raised by APIs that do not return enough error information may be converted to this error."
-			case StatusAlreadyExists:
-				return "The entity that a client attempted to create already exists"
-			case StatusResourceExhausted:
-				return "Some startup has been exhausted" //perhaps a per-user quota, or perhaps the entire file system is out of space."
-			case StatusFailedPrecondition:
-				return "The operation was rejected because the system is not in a state required for the operation's execution" //For example, the directory to be deleted is non-empty, an rmdir operation is applied to a non-directory, etc. Service implementors can use the following guidelines to decide between FAILED_PRECONDITION, ABORTED, and UNAVAILABLE: (a) Use UNAVAILABLE if the client can retry just the failing call. (b) Use ABORTED if the client should retry at a higher level (e.g., when a client-specified test-and-set fails, indicating the client should restart a read-modify-write sequence). (c) Use FAILED_PRECONDITION if the client should not retry until the system state has been explicitly fixed. E.g., if an "rmdir" fails because the directory is non-empty, FAILED_PRECONDITION should be returned since the client should not retry unless the files are deleted from the directory.
-			case StatusAborted:
-				return "The operation was aborted" // typically due to a concurrency issue such as a sequencer check failure or transaction abort. See the guidelines above for deciding between FAILED_PRECONDITION, ABORTED, and UNAVAILABLE."
-			case StatusOutOfRange:
-				return "The operation was attempted past the valid range" // E.g., seeking or reading past end-of-file. Unlike INVALID_ARGUMENT, this error indicates a problem that may be fixed if the system state changes. For example, a 32-bit file system will generate INVALID_ARGUMENT if asked to read at an offset that is not in the range [0,2^32-1], but it will generate OUT_OF_RANGE if asked to read from an offset past the current file size. There is a fair bit of overlap between FAILED_PRECONDITION and OUT_OF_RANGE. We recommend using OUT_OF_RANGE (the more specific error) when it applies so that callers who are iterating through a space can easily look for an OUT_OF_RANGE error to detect when they are done."
-			case StatusUnimplemented:
-				return "The operation is not implemented or is not supported/enabled in this service"
-			case StatusDataLoss:
-				return "Unrecoverable data loss or corruption"
-		*/
+	case http.StatusRequestTimeout:
+		return "Request Timeout"
+	case http.StatusConflict:
+		return "Conflict"
+	case http.StatusPreconditionFailed:
+		return "Precondition Failed"
+	case http.StatusUnprocessableEntity:
+		return "Unprocessable Entity"
+	case http.StatusRequestedRangeNotSatisfiable:
+		return "Range Not Satisfiable"
+	case http.StatusTooManyRequests:
+		return "Too Many Requests"
+	case httpClientClosedRequest:
+		return "Client Closed Request"
+	case http.StatusNotImplemented:
+		return "Not Implemented"
+	case http.StatusBadGateway:
+		return "Bad Gateway"
 	}
 	return fmt.Sprintf("error: code not mapped: %v", code)
 
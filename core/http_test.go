@@ -0,0 +1,69 @@
+package core
+
+import (
+	"fmt"
+	"net/http"
+)
+
+func ExampleHttpCode() {
+	for _, code := range []int{StatusGrpcOK, StatusCancelled, StatusInvalidArgument, StatusDeadlineExceeded, StatusGrpcNotFound,
+		StatusAlreadyExists, StatusResourceExhausted, StatusUnimplemented, StatusUnavailable, StatusDataLoss, StatusInvalidContent, StatusGzipEncodingError, http.StatusTeapot} {
+		fmt.Printf("test: HttpCode(%v) -> [%v] [%v]\n", code, HttpCode(code), HttpStatus(code))
+	}
+
+	//Output:
+	//test: HttpCode(0) -> [500] [OK]
+	//test: HttpCode(1) -> [499] [Cancelled]
+	//test: HttpCode(3) -> [400] [Invalid Argument]
+	//test: HttpCode(4) -> [504] [Deadline Exceeded]
+	//test: HttpCode(5) -> [404] [Not Found]
+	//test: HttpCode(6) -> [409] [Already Exists]
+	//test: HttpCode(8) -> [429] [Resource Exhausted]
+	//test: HttpCode(12) -> [501] [Unimplemented]
+	//test: HttpCode(14) -> [503] [Unavailable]
+	//test: HttpCode(15) -> [500] [Data Loss]
+	//test: HttpCode(90) -> [422] [Invalid Content]
+	//test: HttpCode(100) -> [500] [gzip Encoding Failure]
+	//test: HttpCode(418) -> [418] [I'm A Teapot]
+
+}
+
+func ExampleGrpcHttpCode() {
+	for _, code := range []int{StatusGrpcOK, StatusUnavailable, http.StatusNoContent} {
+		fmt.Printf("test: GrpcHttpCode(%v) -> [%v] [HttpCode:%v]\n", code, GrpcHttpCode(code), HttpCode(code))
+	}
+
+	//Output:
+	//test: GrpcHttpCode(0) -> [200] [HttpCode:500]
+	//test: GrpcHttpCode(14) -> [503] [HttpCode:503]
+	//test: GrpcHttpCode(204) -> [204] [HttpCode:204]
+
+}
+
+func ExampleGrpcCode() {
+	for _, code := range []int{http.StatusOK, http.StatusNoContent, http.StatusBadRequest, http.StatusUnauthorized, http.StatusNotFound,
+		http.StatusTooManyRequests, http.StatusBadGateway, http.StatusGatewayTimeout, http.StatusTeapot, StatusAborted, StatusInvalidContent,
+		StatusIOError, StatusRateLimited, StatusHaveContent, StatusGzipDecodingError, StatusExecError, 42} {
+		fmt.Printf("test: GrpcCode(%v) -> [%v] [%v]\n", code, GrpcCode(code), HttpStatus(GrpcCode(code)))
+	}
+
+	//Output:
+	//test: GrpcCode(200) -> [0] [OK]
+	//test: GrpcCode(204) -> [0] [OK]
+	//test: GrpcCode(400) -> [3] [Invalid Argument]
+	//test: GrpcCode(401) -> [16] [Unauthenticated]
+	//test: GrpcCode(404) -> [5] [Not Found]
+	//test: GrpcCode(429) -> [8] [Resource Exhausted]
+	//test: GrpcCode(502) -> [14] [Unavailable]
+	//test: GrpcCode(504) -> [4] [Deadline Exceeded]
+	//test: GrpcCode(418) -> [2] [Unknown Error]
+	//test: GrpcCode(10) -> [10] [Aborted]
+	//test: GrpcCode(90) -> [3] [Invalid Argument]
+	//test: GrpcCode(91) -> [13] [Internal Error]
+	//test: GrpcCode(97) -> [8] [Resource Exhausted]
+	//test: GrpcCode(99) -> [2] [Unknown Error]
+	//test: GrpcCode(101) -> [13] [Internal Error]
+	//test: GrpcCode(105) -> [13] [Internal Error]
+	//test: GrpcCode(42) -> [2] [Unknown Error]
+
+}
@@ -1,6 +1,8 @@
 package core
 
 import (
+	"iter"
+	"slices"
 	"sync"
 )
 
@@ -16,6 +18,7 @@ func NewSyncMap[T, U any]() *MapT[T, U] {
 	return c
 }
 
+// Load - load a value
 func (m *MapT[T, U]) Load(t T) (u U, ok bool) {
 	v, ok1 := m.m.Load(t)
 	if !ok1 {
@@ -27,6 +30,76 @@ func (m *MapT[T, U]) Load(t T) (u U, ok bool) {
 	return u, false
 }
 
+// Store - store a value
 func (m *MapT[T, U]) Store(t T, u U) {
 	m.m.Store(t, u)
 }
+
+// Delete - delete a key
+func (m *MapT[T, U]) Delete(t T) {
+	m.m.Delete(t)
+}
+
+// LoadOrStore - return the existing value if present, otherwise store and return the given value. The loaded
+// result is true if the value was loaded.
+func (m *MapT[T, U]) LoadOrStore(t T, u U) (actual U, loaded bool) {
+	v, loaded := m.m.LoadOrStore(t, u)
+	if v1, ok := v.(U); ok {
+		return v1, loaded
+	}
+	return actual, loaded
+}
+
+// LoadAndDelete - delete a key, returning the previous value if present
+func (m *MapT[T, U]) LoadAndDelete(t T) (u U, loaded bool) {
+	v, loaded := m.m.LoadAndDelete(t)
+	if !loaded {
+		return u, false
+	}
+	if v1, ok := v.(U); ok {
+		return v1, true
+	}
+	return u, true
+}
+
+// CompareAndSwap - swap the old and new values if the stored value is equal to old. As with sync.Map, this
+// panics if the value type is not comparable, as in a slice, map or func.
+func (m *MapT[T, U]) CompareAndSwap(t T, old, new U) bool {
+	return m.m.CompareAndSwap(t, old, new)
+}
+
+// Len - number of entries, this is O(n)
+func (m *MapT[T, U]) Len() int {
+	count := 0
+	m.m.Range(func(key, value any) bool {
+		count++
+		return true
+	})
+	return count
+}
+
+// Keys - a list of keys sorted by compare, as in cmp.Compare[string], or unsorted if compare is nil
+func (m *MapT[T, U]) Keys(compare func(a, b T) int) []T {
+	var keys []T
+	for t := range m.All() {
+		keys = append(keys, t)
+	}
+	if compare != nil {
+		slices.SortFunc(keys, compare)
+	}
+	return keys
+}
+
+// All - iterator over all entries, in no particular order
+func (m *MapT[T, U]) All() iter.Seq2[T, U] {
+	return func(yield func(T, U) bool) {
+		m.m.Range(func(key, value any) bool {
+			t, ok := key.(T)
+			if !ok {
+				return true
+			}
+			u, _ := value.(U)
+			return yield(t, u)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package core
 
 import (
+	"cmp"
 	"fmt"
 )
 
@@ -64,3 +65,82 @@ func ExampleNewMap_Address() {
 	//test:  Load("common:core:ctor/invalid") -> {  } [ok:false]
 
 }
+
+func ExampleMapT_Keys() {
+	m := NewSyncMap[string, int]()
+	m.Store("common:core:agent/c", 3)
+	m.Store("common:core:agent/a", 1)
+	m.Store("common:core:agent/b", 2)
+	fmt.Printf("test: Keys() -> %v [len:%v]\n", m.Keys(cmp.Compare[string]), m.Len())
+
+	sum := 0
+	for _, v := range m.All() {
+		sum += v
+	}
+	fmt.Printf("test: All() -> [sum:%v]\n", sum)
+
+	for k := range m.All() {
+		m.Delete(k)
+		break
+	}
+	fmt.Printf("test: Delete() -> [len:%v]\n", m.Len())
+
+	m64 := NewSyncMap[int64, bool]()
+	for _, k := range []int64{100, -5, 20, 3} {
+		m64.Store(k, true)
+	}
+	mu := NewSyncMap[uint, bool]()
+	for _, k := range []uint{10, 9, 100} {
+		mu.Store(k, true)
+	}
+	fmt.Printf("test: Keys() -> [int64:%v] [uint:%v]\n", m64.Keys(cmp.Compare[int64]), mu.Keys(cmp.Compare[uint]))
+
+	type version struct{ major, minor int }
+	mv := NewSyncMap[version, bool]()
+	for _, k := range []version{{2, 0}, {1, 10}, {1, 2}} {
+		mv.Store(k, true)
+	}
+	keys := mv.Keys(func(a, b version) int {
+		return cmp.Or(cmp.Compare(a.major, b.major), cmp.Compare(a.minor, b.minor))
+	})
+	fmt.Printf("test: Keys() -> [version:%v] [unsorted:%v]\n", keys, len(mv.Keys(nil)))
+
+	//Output:
+	//test: Keys() -> [common:core:agent/a common:core:agent/b common:core:agent/c] [len:3]
+	//test: All() -> [sum:6]
+	//test: Delete() -> [len:2]
+	//test: Keys() -> [int64:[-5 3 20 100]] [uint:[9 10 100]]
+	//test: Keys() -> [version:[{1 2} {1 10} {2 0}]] [unsorted:3]
+
+}
+
+func ExampleMapT_LoadOrStore() {
+	m := NewSyncMap[string, int]()
+	v, loaded := m.LoadOrStore("a", 1)
+	fmt.Printf("test: LoadOrStore(\"a\",1) -> [%v] [loaded:%v]\n", v, loaded)
+
+	v, loaded = m.LoadOrStore("a", 2)
+	fmt.Printf("test: LoadOrStore(\"a\",2) -> [%v] [loaded:%v]\n", v, loaded)
+
+	ok := m.CompareAndSwap("a", 2, 3)
+	fmt.Printf("test: CompareAndSwap(\"a\",2,3) -> [%v]\n", ok)
+
+	ok = m.CompareAndSwap("a", 1, 3)
+	v, _ = m.Load("a")
+	fmt.Printf("test: CompareAndSwap(\"a\",1,3) -> [%v] [%v]\n", ok, v)
+
+	v, loaded = m.LoadAndDelete("a")
+	fmt.Printf("test: LoadAndDelete(\"a\") -> [%v] [loaded:%v] [len:%v]\n", v, loaded, m.Len())
+
+	v, loaded = m.LoadAndDelete("a")
+	fmt.Printf("test: LoadAndDelete(\"a\") -> [%v] [loaded:%v]\n", v, loaded)
+
+	//Output:
+	//test: LoadOrStore("a",1) -> [1] [loaded:false]
+	//test: LoadOrStore("a",2) -> [1] [loaded:true]
+	//test: CompareAndSwap("a",2,3) -> [false]
+	//test: CompareAndSwap("a",1,3) -> [true] [3]
+	//test: LoadAndDelete("a") -> [3] [loaded:true] [len:0]
+	//test: LoadAndDelete("a") -> [0] [loaded:false]
+
+}
@@ -1,10 +1,11 @@
 package core
 
 import (
+	"errors"
 	"fmt"
+	"log"
 	"net/url"
 	"strings"
-	"sync/atomic"
 )
 
 const (
@@ -13,10 +14,6 @@ const (
 	Slash    = "/"
 )
 
-var (
-	counter = new(atomic.Int64)
-)
-
 type Name struct {
 	Collective string `json:"collective"`
 	Domain     string `json:"domain"`
@@ -29,12 +26,111 @@ func NewName(name string) Name {
 	return parse(name)
 }
 
+// ParseName - validating parse of a name in the canonical form: collective:domain:kind/path#fragment
+func ParseName(name string) (Name, error) {
+	return parseStrict(name)
+}
+
+// String - canonical form: collective:domain:kind/path#fragment
+func (n Name) String() string {
+	if n == (Name{}) {
+		return ""
+	}
+	s := n.Collective + Colon + n.Domain + Colon + n.Kind + n.Path
+	if n.Fragment != "" {
+		s += Fragment + n.Fragment
+	}
+	return s
+}
+
+// Validate - validate a name
+func (n Name) Validate() error {
+	if err := validSegment("collective", n.Collective); err != nil {
+		return err
+	}
+	if err := validSegment("domain", n.Domain); err != nil {
+		return err
+	}
+	if err := validSegment("kind", n.Kind); err != nil {
+		return err
+	}
+	if !strings.HasPrefix(n.Path, Slash) {
+		return errors.New(fmt.Sprintf("name path is invalid, missing leading slash: [%v]", n.Path))
+	}
+	for _, segment := range strings.Split(n.Path[1:], Slash) {
+		if err := validSegment("path", segment); err != nil {
+			return err
+		}
+	}
+	if n.Fragment != "" {
+		// A fragment is a version, and semantic version build metadata includes '+'
+		if _, err := ParseVersion(n.Fragment); err != nil {
+			return errors.New(fmt.Sprintf("name fragment is not a valid version %q", n.Fragment))
+		}
+	}
+	return nil
+}
+
+// NameBuilder - build a canonical name
+type NameBuilder struct {
+	n Name
+}
+
+// NewNameBuilder - create a name builder
+func NewNameBuilder(collective, domain, kind string) *NameBuilder {
+	b := new(NameBuilder)
+	b.n.Collective = collective
+	b.n.Domain = domain
+	b.n.Kind = kind
+	return b
+}
+
+// Path - append path segments
+func (b *NameBuilder) Path(segments ...string) *NameBuilder {
+	for _, s := range segments {
+		b.n.Path += Slash + s
+	}
+	return b
+}
+
+// Fragment - set the fragment
+func (b *NameBuilder) Fragment(fragment string) *NameBuilder {
+	b.n.Fragment = fragment
+	return b
+}
+
+// Build - validate and return the name
+func (b *NameBuilder) Build() (Name, error) {
+	if err := b.n.Validate(); err != nil {
+		return Name{}, err
+	}
+	return b.n, nil
+}
+
+// String - build the canonical form, an invalid name returns an empty string
+func (b *NameBuilder) String() string {
+	n, err := b.Build()
+	if err != nil {
+		return ""
+	}
+	return n.String()
+}
+
 func AddFragment(name, fragment string) string {
 	return addFragment(name, fragment)
 }
 
+// Versioned - the Sequencer.Versioned name from the sequencer set by SetSequencer, so an un-fragmented name has the
+// next value of its sequence added as the fragment. A sequence error is logged, and the name is returned unchanged.
+// The default sequencer is in memory and restarts at 1, so callers that need versions to survive a restart set a
+// sequencer with a SequenceStore, such as a FileSequenceStore.
 func Versioned(name string) string {
-	return AddFragment(name, fmt.Sprintf("%v", counter.Add(1)))
+	versioned, err := sequencer.Load().Versioned(name)
+	if err != nil {
+		log.Printf("versioned name %v: %v", name, err)
+		return name
+	}
+	return versioned
 }
 
 /*
@@ -85,6 +181,58 @@ func parse(name string) Name {
 	return n
 }
 
+func parseStrict(name string) (Name, error) {
+	if name == "" {
+		return Name{}, errors.New("name is empty")
+	}
+	var n Name
+	s := name
+	if i := strings.Index(s, Fragment); i >= 0 {
+		n.Fragment = s[i+1:]
+		if n.Fragment == "" {
+			return Name{}, errors.New(fmt.Sprintf("name fragment is empty: [%v]", name))
+		}
+		s = s[:i]
+	}
+	i := strings.Index(s, Colon)
+	if i < 0 {
+		return Name{}, errors.New(fmt.Sprintf("name is missing first colon: [%v]", name))
+	}
+	n.Collective = s[:i]
+	s = s[i+1:]
+	i = strings.Index(s, Colon)
+	if i < 0 {
+		return Name{}, errors.New(fmt.Sprintf("name is missing second colon: [%v]", name))
+	}
+	n.Domain = s[:i]
+	s = s[i+1:]
+	i = strings.Index(s, Slash)
+	if i < 0 {
+		return Name{}, errors.New(fmt.Sprintf("name is missing path: [%v]", name))
+	}
+	n.Kind = s[:i]
+	n.Path = s[i:]
+	if err := n.Validate(); err != nil {
+		return Name{}, errors.New(fmt.Sprintf("%v: [%v]", err, name))
+	}
+	return n, nil
+}
+
+// validSegment - letters, digits, and '-', '.', '_' are valid
+func validSegment(field, s string) error {
+	if s == "" {
+		return errors.New(fmt.Sprintf("name %v is empty", field))
+	}
+	for _, r := range s {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '.', r == '_':
+		default:
+			return errors.New(fmt.Sprintf("name %v has an invalid character %q", field, r))
+		}
+	}
+	return nil
+}
+
 func addFragment(name, fragment string) string {
 	if name == "" {
 		return name
@@ -1,8 +1,12 @@
 package core
 
 import (
+	"errors"
 	"fmt"
+	"io"
+	"log"
 	"net/url"
+	"os"
 )
 
 func ExampleParse() {
@@ -19,8 +23,62 @@ func ExampleParse() {
 
 	//Output:
 	//test: url.Parse() [wikipedia-eng:resiliency-traffic:agent/rate-limiting/request/http#v1.2.3] [err:<nil>]
-	//test: parse("wikipedia-eng:resiliency-traffic:agent/rate-limiting/request/http#v1.2.3") -> {wikipedia-eng resiliency-traffic agent /rate-limiting/request/http v1.2.3}
-	//test: parse("wikipedia.eng:resiliency.traffic:agent/rate-limiting/request/http") -> {wikipedia.eng resiliency.traffic agent /rate-limiting/request/http }
+	//test: parse("wikipedia-eng:resiliency-traffic:agent/rate-limiting/request/http#v1.2.3") -> wikipedia-eng:resiliency-traffic:agent/rate-limiting/request/http#v1.2.3
+	//test: parse("wikipedia.eng:resiliency.traffic:agent/rate-limiting/request/http") -> wikipedia.eng:resiliency.traffic:agent/rate-limiting/request/http
+
+}
+
+func ExampleParseName() {
+	for _, s := range []string{
+		"common:core:event/startup",
+		"wikipedia-eng:resiliency-traffic:agent/rate-limiting/request/http#v1.2.3",
+		"",
+		"common:core",
+		"common:core:event",
+		"common:core:event/",
+		"common:core:event/config#",
+		"common::event/config",
+		"common:core:event/con fig",
+		"common:core:event//config",
+		"acme:billing:agent/rater#1.4.2+build.7",
+		"acme:billing:agent/rater#latest",
+	} {
+		n, err := ParseName(s)
+		fmt.Printf("test: ParseName(\"%v\") -> [%v] [fragment:%v] [round-trip:%v] [err:%v]\n", s, n.Path, n.Fragment, err == nil && n.String() == s, err)
+	}
+
+	//Output:
+	//test: ParseName("common:core:event/startup") -> [/startup] [fragment:] [round-trip:true] [err:<nil>]
+	//test: ParseName("wikipedia-eng:resiliency-traffic:agent/rate-limiting/request/http#v1.2.3") -> [/rate-limiting/request/http] [fragment:v1.2.3] [round-trip:true] [err:<nil>]
+	//test: ParseName("") -> [] [fragment:] [round-trip:false] [err:name is empty]
+	//test: ParseName("common:core") -> [] [fragment:] [round-trip:false] [err:name is missing second colon: [common:core]]
+	//test: ParseName("common:core:event") -> [] [fragment:] [round-trip:false] [err:name is missing path: [common:core:event]]
+	//test: ParseName("common:core:event/") -> [] [fragment:] [round-trip:false] [err:name path is empty: [common:core:event/]]
+	//test: ParseName("common:core:event/config#") -> [] [fragment:] [round-trip:false] [err:name fragment is empty: [common:core:event/config#]]
+	//test: ParseName("common::event/config") -> [] [fragment:] [round-trip:false] [err:name domain is empty: [common::event/config]]
+	//test: ParseName("common:core:event/con fig") -> [] [fragment:] [round-trip:false] [err:name path has an invalid character ' ': [common:core:event/con fig]]
+	//test: ParseName("common:core:event//config") -> [] [fragment:] [round-trip:false] [err:name path is empty: [common:core:event//config]]
+	//test: ParseName("acme:billing:agent/rater#1.4.2+build.7") -> [/rater] [fragment:1.4.2+build.7] [round-trip:true] [err:<nil>]
+	//test: ParseName("acme:billing:agent/rater#latest") -> [] [fragment:] [round-trip:false] [err:name fragment is not a valid version "latest": [acme:billing:agent/rater#latest]]
+
+}
+
+func ExampleNewNameBuilder() {
+	b := NewNameBuilder("acme", "billing", "agent").Path("rater", "v2").Fragment("1.4.2")
+	n, err := b.Build()
+	fmt.Printf("test: Build() -> [%v] [err:%v]\n", n, err)
+
+	n2, err2 := ParseName(b.String())
+	fmt.Printf("test: ParseName(Build()) -> [equal:%v] [err:%v]\n", n == n2, err2)
+
+	b = NewNameBuilder("acme", "billing", "agent/rater")
+	n, err = b.Build()
+	fmt.Printf("test: Build() -> [%v] [string:%v] [err:%v]\n", n, b.String(), err)
+
+	//Output:
+	//test: Build() -> [acme:billing:agent/rater/v2#1.4.2] [err:<nil>]
+	//test: ParseName(Build()) -> [equal:true] [err:<nil>]
+	//test: Build() -> [] [string:] [err:name kind has an invalid character '/']
 
 }
 
@@ -39,12 +97,34 @@ func ExampleAddVersion() {
 
 }
 
+type failSequenceStore struct{}
+
+func (failSequenceStore) Load(name string) (int64, error) {
+	return 0, errors.New("store is unavailable")
+}
+func (failSequenceStore) Store(name string, value int64) error {
+	return errors.New("store is unavailable")
+}
+
 func ExampleVersioned() {
 	s := "wikipedia-eng:resiliency-traffic:agent/rate-limiting/request/http"
 	name := Versioned(s)
 	fmt.Printf("test: Versioned(\"%v\") -> %v\n", s, name)
 
+	name = Versioned(name)
+	fmt.Printf("test: Versioned(\"%v#1\") -> %v\n", s, name)
+
+	prev := sequencer.Load()
+	SetSequencer(NewSequencer(failSequenceStore{}))
+	log.SetOutput(io.Discard)
+	name = Versioned(s)
+	log.SetOutput(os.Stderr)
+	SetSequencer(prev)
+	fmt.Printf("test: Versioned(\"%v\") -> %v\n", s, name)
+
 	//Output:
 	//test: Versioned("wikipedia-eng:resiliency-traffic:agent/rate-limiting/request/http") -> wikipedia-eng:resiliency-traffic:agent/rate-limiting/request/http#1
+	//test: Versioned("wikipedia-eng:resiliency-traffic:agent/rate-limiting/request/http#1") -> wikipedia-eng:resiliency-traffic:agent/rate-limiting/request/http#1
+	//test: Versioned("wikipedia-eng:resiliency-traffic:agent/rate-limiting/request/http") -> wikipedia-eng:resiliency-traffic:agent/rate-limiting/request/http
 
 }
@@ -0,0 +1,200 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"runtime"
+	"strings"
+)
+
+const (
+	LinkTypeFunc      = "func"
+	LinkTypeChainable = "chainable"
+)
+
+// Namer - optional interface for a Chainable operative to provide a network link name
+type Namer interface {
+	Name() string
+}
+
+// NamedOperative - an operative with a network link name
+type NamedOperative struct {
+	Name      string
+	Operative any
+}
+
+// Named - name an operative, the operative is a func(next Exchange) Exchange or a Chainable[Exchange]
+func Named(name string, operative any) NamedOperative {
+	return NamedOperative{Name: name, Operative: operative}
+}
+
+// Link - a network link
+type Link struct {
+	Index int    `json:"index"`
+	Name  string `json:"name"`
+	Type  string `json:"type"`
+}
+
+// Network - a chain of Exchange links
+type Network struct {
+	head  Exchange
+	links []Link
+}
+
+// NewNetwork - build a network, returning an error on a nil or invalid type operative. Operatives that are not
+// named use the function or type name.
+func NewNetwork(operatives []any) (*Network, error) {
+	head, links, err := linkNetwork[Exchange, Chainable[Exchange]](operatives)
+	if err != nil {
+		return nil, err
+	}
+	return &Network{head: head, links: links}, nil
+}
+
+// Exchange - the head of the network
+func (n *Network) Exchange() Exchange {
+	return n.head
+}
+
+// Links - network links in order
+func (n *Network) Links() []Link {
+	return append([]Link(nil), n.links...)
+}
+
+// String - link names in order
+func (n *Network) String() string {
+	names := make([]string, len(n.links))
+	for i, l := range n.links {
+		names[i] = l.Name
+	}
+	return strings.Join(names, " -> ")
+}
+
+// DOT - Graphviz DOT rendering, https://graphviz.org/doc/info/lang.html
+func (n *Network) DOT() string {
+	var sb strings.Builder
+	sb.WriteString("digraph network {\n")
+	sb.WriteString("  rankdir=LR;\n")
+	for _, l := range n.links {
+		sb.WriteString(fmt.Sprintf("  n%v [label=%v];\n", l.Index, dotQuote(l.Name)))
+	}
+	for i := 1; i < len(n.links); i++ {
+		sb.WriteString(fmt.Sprintf("  n%v -> n%v;\n", i-1, i))
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// MarshalJSON - JSON rendering of the network links
+func (n *Network) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Links []Link `json:"links"`
+	}{Links: n.links})
+}
+
+// validateNetwork - check the operatives of a network, without calling the operatives to build the links
+func validateNetwork[T any, U Chainable[T]](operatives []any) error {
+	if len(operatives) == 0 {
+		return errors.New("operatives list is nil")
+	}
+	for i, op := range operatives {
+		if named, ok := op.(NamedOperative); ok {
+			op = named.Operative
+		}
+		if op == nil {
+			return errors.New(fmt.Sprintf("operative is nil at index: %v", i))
+		}
+		if _, ok := op.(func(next T) T); ok {
+			continue
+		}
+		if _, ok := op.(U); !ok {
+			return errors.New(fmt.Sprintf("invalid operative type: %v", reflect.TypeOf(op)))
+		}
+	}
+	return nil
+}
+
+// linkNetwork - build a chain of links, returning an error on nil or invalid type links
+func linkNetwork[T any, U Chainable[T]](operatives []any) (head T, links []Link, err error) {
+	if len(operatives) == 0 {
+		return head, nil, errors.New("operatives list is nil")
+	}
+	links = make([]Link, len(operatives))
+	for i := len(operatives) - 1; i >= 0; i-- {
+		op := operatives[i]
+		name := ""
+		if named, ok := op.(NamedOperative); ok {
+			name = named.Name
+			op = named.Operative
+		}
+		if op == nil {
+			return head, nil, errors.New(fmt.Sprintf("operative is nil at index: %v", i))
+		}
+		// Only configured names are traced, as the trace is returned to clients
+		trace := configuredName(name, op)
+		// Check for a next function
+		if fn, ok := op.(func(next T) T); ok {
+			links[i] = Link{Index: i, Name: operativeName(name, op), Type: LinkTypeFunc}
+			head = traceLink(fn(head), trace)
+			continue
+		}
+		// Check for a Chainable interface
+		if c, ok := op.(U); ok {
+			links[i] = Link{Index: i, Name: operativeName(name, op), Type: LinkTypeChainable}
+			head = traceLink(c.Link(head), trace)
+			continue
+		}
+		return head, nil, errors.New(fmt.Sprintf("invalid operative type: %v", reflect.TypeOf(op)))
+	}
+	return head, links, nil
+}
+
+// traceLink - for an Exchange link with a name, add the name to the trace of a *Status returned in the error chain
+func traceLink[T any](t T, name string) T {
+	next, ok := any(t).(Exchange)
+	if !ok || next == nil || name == "" {
+		return t
+	}
+	var link any = Exchange(func(r *http.Request) (*http.Response, error) {
+		resp, err := next(r)
+		return resp, Location(err, name)
+	})
+	return link.(T)
+}
+
+// configuredName - the NamedOperative or Namer name, and not the function or type name
+func configuredName(name string, op any) string {
+	if name != "" {
+		return name
+	}
+	if n, ok := op.(Namer); ok {
+		return n.Name()
+	}
+	return ""
+}
+
+func operativeName(name string, op any) string {
+	if name = configuredName(name, op); name != "" {
+		return name
+	}
+	v := reflect.ValueOf(op)
+	if v.Kind() == reflect.Func {
+		if fn := runtime.FuncForPC(v.Pointer()); fn != nil {
+			name = fn.Name()
+		}
+	} else {
+		name = v.Type().String()
+	}
+	// Remove the package path
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+	return name
+}
+
+func dotQuote(s string) string {
+	return "\"" + strings.ReplaceAll(strings.ReplaceAll(s, "\\", "\\\\"), "\"", "\\\"") + "\""
+}
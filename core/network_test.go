@@ -0,0 +1,96 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+func (d do2Exchange) Name() string {
+	return "do2"
+}
+
+func ExampleNewNetwork() {
+	n, err := NewNetwork([]any{Named("do1", do1ExchangeFn), do2Exchange{}, do3ExchangeFn})
+	fmt.Printf("test: NewNetwork() -> %v [err:%v]\n", n, err)
+
+	req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://www.google.com/search?q=golang", nil)
+	n.Exchange()(req)
+
+	buf, _ := json.Marshal(n)
+	fmt.Printf("test: MarshalJSON() -> %v\n", string(buf))
+
+	//Output:
+	//test: NewNetwork() -> do1 -> do2 -> core.do3ExchangeFn [err:<nil>]
+	//test: Do1-Exchange() -> request
+	//test: Do2-Exchange() -> request
+	//test: Do3-Exchange() -> request
+	//test: Do3-Exchange() -> response
+	//test: Do2-Exchange() -> response
+	//test: Do1-Exchange() -> response
+	//test: MarshalJSON() -> {"links":[{"index":0,"name":"do1","type":"func"},{"index":1,"name":"do2","type":"chainable"},{"index":2,"name":"core.do3ExchangeFn","type":"func"}]}
+
+}
+
+func ExampleNewNetwork_error() {
+	req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://www.google.com/search?q=golang", nil)
+
+	_, err := NewNetwork(nil)
+	fmt.Printf("test: NewNetwork() -> [err:%v]\n", err)
+
+	_, err = NewNetwork([]any{do1Exchange{}, Named("nil", nil), do3Exchange{}})
+	fmt.Printf("test: NewNetwork() -> [err:%v]\n", err)
+
+	_, err = NewNetwork([]any{do1Exchange{}, req, do3Exchange{}})
+	fmt.Printf("test: NewNetwork() -> [err:%v]\n", err)
+
+	//Output:
+	//test: NewNetwork() -> [err:operatives list is nil]
+	//test: NewNetwork() -> [err:operative is nil at index: 1]
+	//test: NewNetwork() -> [err:invalid operative type: *http.Request]
+
+}
+
+func ExampleNetwork_DOT() {
+	n, _ := NewNetwork([]any{Named("auth", do1ExchangeFn), Named("cache \"v2\"", do2ExchangeFn), do3Exchange{}})
+	fmt.Printf("test: DOT() ->\n%v", n.DOT())
+
+	//Output:
+	//test: DOT() ->
+	//digraph network {
+	//   rankdir=LR;
+	//   n0 [label="auth"];
+	//   n1 [label="cache \"v2\""];
+	//   n2 [label="core.do3Exchange"];
+	//   n0 -> n1;
+	//   n1 -> n2;
+	//}
+
+}
+
+var errUnavailable = NewStatus(StatusUnavailable, errors.New("upstream is unavailable"))
+
+func ExampleNewNetwork_trace() {
+	origin := func(next Exchange) Exchange {
+		return func(r *http.Request) (*http.Response, error) {
+			return nil, errUnavailable
+		}
+	}
+	n, _ := NewNetwork([]any{Named("auth", do1ExchangeFn), Named("origin", origin)})
+	req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://www.google.com/search?q=golang", nil)
+	_, err := n.Exchange()(req)
+
+	var s *Status
+	errors.As(err, &s)
+	fmt.Printf("test: Exchange() -> [origin:%v] [location:%v] [trace:%v]\n", s.Origin(), s.Location, s.Trace)
+	fmt.Printf("test: Exchange() -> [is:%v] [sentinel:%v]\n", errors.Is(err, errUnavailable), errUnavailable.Trace)
+
+	//Output:
+	//test: Do1-Exchange() -> request
+	//test: Do1-Exchange() -> response
+	//test: Exchange() -> [origin:origin] [location:auth] [trace:[origin auth]]
+	//test: Exchange() -> [is:true] [sentinel:[]]
+
+}
@@ -0,0 +1,138 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	Wildcard       = "*"
+	WildcardSuffix = "**"
+)
+
+// NamePattern - compiled name pattern, as in common:*:event/** or acme:orders:agent/*
+// A '*' matches exactly one collective, domain, kind, path or fragment segment, and a trailing '**' path segment
+// matches zero or more path segments. A pattern without a fragment matches names with any, or no, fragment.
+type NamePattern struct {
+	pattern  string
+	names    [3]string // collective, domain, kind
+	path     []string
+	suffix   bool
+	fragment string
+}
+
+// CompileNamePattern - compile a name pattern
+func CompileNamePattern(pattern string) (*NamePattern, error) {
+	if pattern == "" {
+		return nil, errors.New("name pattern is empty")
+	}
+	p := new(NamePattern)
+	p.pattern = pattern
+	s := pattern
+	if i := strings.Index(s, Fragment); i >= 0 {
+		p.fragment = s[i+1:]
+		if err := validPatternSegment("fragment", p.fragment); err != nil {
+			return nil, errors.New(fmt.Sprintf("%v: [%v]", err, pattern))
+		}
+		s = s[:i]
+	}
+	for i, field := range []string{"collective", "domain"} {
+		seg, rest, ok := strings.Cut(s, Colon)
+		if !ok {
+			return nil, errors.New(fmt.Sprintf("name pattern is missing colon after %v: [%v]", field, pattern))
+		}
+		if err := validPatternSegment(field, seg); err != nil {
+			return nil, errors.New(fmt.Sprintf("%v: [%v]", err, pattern))
+		}
+		p.names[i] = seg
+		s = rest
+	}
+	seg, path, ok := strings.Cut(s, Slash)
+	if !ok {
+		return nil, errors.New(fmt.Sprintf("name pattern is missing path: [%v]", pattern))
+	}
+	if err := validPatternSegment("kind", seg); err != nil {
+		return nil, errors.New(fmt.Sprintf("%v: [%v]", err, pattern))
+	}
+	p.names[2] = seg
+	segments := strings.Split(path, Slash)
+	for i, seg1 := range segments {
+		if seg1 == WildcardSuffix {
+			if i != len(segments)-1 {
+				return nil, errors.New(fmt.Sprintf("name pattern '**' is only valid as the last path segment: [%v]", pattern))
+			}
+			p.suffix = true
+			break
+		}
+		if err := validPatternSegment("path", seg1); err != nil {
+			return nil, errors.New(fmt.Sprintf("%v: [%v]", err, pattern))
+		}
+		p.path = append(p.path, seg1)
+	}
+	return p, nil
+}
+
+// MustCompileNamePattern - compile a name pattern, panic on error
+func MustCompileNamePattern(pattern string) *NamePattern {
+	p, err := CompileNamePattern(pattern)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// String - the pattern
+func (p *NamePattern) String() string {
+	return p.pattern
+}
+
+// MatchName - match a parsed name
+func (p *NamePattern) MatchName(n Name) bool {
+	return p.Match(n.String())
+}
+
+// Match - match a name, no allocations are made
+func (p *NamePattern) Match(name string) bool {
+	s, fragment, hasFragment := strings.Cut(name, Fragment)
+	if p.fragment != "" && (!hasFragment || !matchSegment(p.fragment, fragment)) {
+		return false
+	}
+	for i := 0; i < 2; i++ {
+		seg, rest, ok := strings.Cut(s, Colon)
+		if !ok || !matchSegment(p.names[i], seg) {
+			return false
+		}
+		s = rest
+	}
+	seg, path, ok := strings.Cut(s, Slash)
+	if !ok || !matchSegment(p.names[2], seg) {
+		return false
+	}
+	for i, pseg := range p.path {
+		seg, rest, more := strings.Cut(path, Slash)
+		if !matchSegment(pseg, seg) {
+			return false
+		}
+		if !more {
+			return i == len(p.path)-1
+		}
+		path = rest
+	}
+	// Remaining name path segments only match a suffix
+	return p.suffix
+}
+
+func matchSegment(pattern, s string) bool {
+	if s == "" {
+		return false
+	}
+	return pattern == Wildcard || pattern == s
+}
+
+func validPatternSegment(field, s string) error {
+	if s == Wildcard {
+		return nil
+	}
+	return validSegment(field, s)
+}
@@ -0,0 +1,49 @@
+package core
+
+import "fmt"
+
+func ExampleCompileNamePattern() {
+	for _, s := range []string{"common:*:event/**", "acme:orders:agent/*", "common:core:event/**/config", "common:core:event", "common:c?re:event/*", ""} {
+		p, err := CompileNamePattern(s)
+		fmt.Printf("test: CompileNamePattern(\"%v\") -> [%v] [err:%v]\n", s, p != nil, err)
+	}
+
+	//Output:
+	//test: CompileNamePattern("common:*:event/**") -> [true] [err:<nil>]
+	//test: CompileNamePattern("acme:orders:agent/*") -> [true] [err:<nil>]
+	//test: CompileNamePattern("common:core:event/**/config") -> [false] [err:name pattern '**' is only valid as the last path segment: [common:core:event/**/config]]
+	//test: CompileNamePattern("common:core:event") -> [false] [err:name pattern is missing path: [common:core:event]]
+	//test: CompileNamePattern("common:c?re:event/*") -> [false] [err:name domain has an invalid character '?': [common:c?re:event/*]]
+	//test: CompileNamePattern("") -> [false] [err:name pattern is empty]
+
+}
+
+func ExampleNamePattern_Match() {
+	names := []string{
+		"common:core:event/startup",
+		"common:core:event/config/agent",
+		"common:messaging:event/status#2",
+		"acme:orders:agent/rater",
+		"acme:orders:agent/rater/v2",
+		"acme:orders:agent/rater#1.4.2",
+		"acme:billing:agent/rater",
+		"urn:test:one",
+	}
+	for _, s := range []string{"common:*:event/**", "acme:orders:agent/*", "acme:*:agent/rater#1.4.2", "*:*:*/rater/*"} {
+		p := MustCompileNamePattern(s)
+		var matched []string
+		for _, name := range names {
+			if p.Match(name) {
+				matched = append(matched, name)
+			}
+		}
+		fmt.Printf("test: Match(\"%v\") -> %v\n", p, matched)
+	}
+
+	//Output:
+	//test: Match("common:*:event/**") -> [common:core:event/startup common:core:event/config/agent common:messaging:event/status#2]
+	//test: Match("acme:orders:agent/*") -> [acme:orders:agent/rater acme:orders:agent/rater#1.4.2]
+	//test: Match("acme:*:agent/rater#1.4.2") -> [acme:orders:agent/rater#1.4.2]
+	//test: Match("*:*:*/rater/*") -> [acme:orders:agent/rater/v2]
+
+}
@@ -0,0 +1,154 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+const (
+	ContentTypeProblemJson = "application/problem+json"
+	ProblemTypeBlank       = "about:blank"
+
+	problemCode  = "code"
+	problemTrace = "trace"
+)
+
+var (
+	problemMembers = []string{"type", "title", "status", "detail", "instance"}
+)
+
+// Problem - RFC 9457 problem details, https://www.rfc-editor.org/rfc/rfc9457
+type Problem struct {
+	Type       string         `json:"type,omitempty"`
+	Title      string         `json:"title,omitempty"`
+	Status     int            `json:"status,omitempty"`
+	Detail     string         `json:"detail,omitempty"`
+	Instance   string         `json:"instance,omitempty"`
+	Extensions map[string]any `json:"-"`
+}
+
+// MarshalJSON - extension members are written at the top level, and cannot override the standard members
+func (p Problem) MarshalJSON() ([]byte, error) {
+	m := make(map[string]any, len(p.Extensions)+len(problemMembers))
+	for k, v := range p.Extensions {
+		m[k] = v
+	}
+	for _, k := range problemMembers {
+		delete(m, k)
+	}
+	if p.Type != "" {
+		m["type"] = p.Type
+	}
+	if p.Title != "" {
+		m["title"] = p.Title
+	}
+	if p.Status != 0 {
+		m["status"] = p.Status
+	}
+	if p.Detail != "" {
+		m["detail"] = p.Detail
+	}
+	if p.Instance != "" {
+		m["instance"] = p.Instance
+	}
+	return json.Marshal(m)
+}
+
+// UnmarshalJSON - members that are not standard are collected as extensions
+func (p *Problem) UnmarshalJSON(buf []byte) error {
+	type standard Problem
+	var s standard
+	if err := json.Unmarshal(buf, &s); err != nil {
+		return err
+	}
+	var m map[string]any
+	if err := json.Unmarshal(buf, &m); err != nil {
+		return err
+	}
+	for _, k := range problemMembers {
+		delete(m, k)
+	}
+	*p = Problem(s)
+	if len(m) > 0 {
+		p.Extensions = m
+	}
+	return nil
+}
+
+// Problem - create a problem details from a status. The HTTP status is from HttpCode, and the original code
+// and location trace are added as extensions. The error is the detail for a 4xx status only, as the error of a 5xx
+// status can expose internal details, such as file paths or SQL, to clients.
+func (s *Status) Problem() *Problem {
+	p := new(Problem)
+	p.Type = ProblemTypeBlank
+	p.Status = HttpCode(s.Code)
+	p.Title = HttpStatus(s.Code)
+	if s.Err != nil && p.Status < http.StatusInternalServerError {
+		p.Detail = s.Err.Error()
+	}
+	if s.Code != p.Status {
+		p.addExtension(problemCode, s.Code)
+	}
+	if len(s.Trace) > 0 {
+		p.addExtension(problemTrace, s.Trace)
+	}
+	var v *ValidationError
+	if errors.As(s.Err, &v) {
+		p.addExtension(problemViolations, v.Violations)
+	}
+	return p
+}
+
+// MarshalProblem - status -> application/problem+json
+func (s *Status) MarshalProblem() ([]byte, error) {
+	return json.Marshal(s.Problem())
+}
+
+// ParseProblem - application/problem+json -> status
+func ParseProblem(buf []byte) (*Status, error) {
+	if len(buf) == 0 {
+		return nil, errors.New("problem content is empty")
+	}
+	p := new(Problem)
+	if err := json.Unmarshal(buf, p); err != nil {
+		return nil, errors.New(fmt.Sprintf("JSON unmarshalling %v", err))
+	}
+	return p.NewStatus(), nil
+}
+
+// NewStatus - create a status from a problem details, restoring the code and location trace extensions
+func (p *Problem) NewStatus() *Status {
+	s := NewStatus(p.Status, nil)
+	if p.Detail != "" {
+		s.Err = errors.New(p.Detail)
+	}
+	switch code := p.Extensions[problemCode].(type) {
+	case float64:
+		s.Code = int(code)
+	case int:
+		s.Code = code
+	}
+	switch trace := p.Extensions[problemTrace].(type) {
+	case []string:
+		s.Trace = append(s.Trace, trace...)
+	case []any:
+		for _, t := range trace {
+			if location, ok := t.(string); ok {
+				s.Trace = append(s.Trace, location)
+			}
+		}
+	}
+	if len(s.Trace) > 0 {
+		s.Location = s.Trace[len(s.Trace)-1]
+	}
+	return s
+}
+
+func (p *Problem) addExtension(name string, value any) {
+	if p.Extensions == nil {
+		p.Extensions = make(map[string]any)
+	}
+	p.Extensions[name] = value
+}
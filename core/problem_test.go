@@ -0,0 +1,48 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+func ExampleStatus_Problem() {
+	s := NewStatus(StatusInvalidArgument, errors.New("name is empty")).SetLocation("acme:orders:agent/validate")
+	buf, err := s.MarshalProblem()
+	fmt.Printf("test: MarshalProblem() -> %v [err:%v]\n", string(buf), err)
+
+	s = NewStatus(http.StatusNotFound, nil)
+	buf, err = s.MarshalProblem()
+	fmt.Printf("test: MarshalProblem() -> %v [err:%v]\n", string(buf), err)
+
+	s = NewStatus(http.StatusInternalServerError, errors.New("open /var/lib/orders.db: permission denied"))
+	buf, err = s.MarshalProblem()
+	fmt.Printf("test: MarshalProblem() -> %v [err:%v]\n", string(buf), err)
+
+	//Output:
+	//test: MarshalProblem() -> {"code":3,"detail":"name is empty","status":400,"title":"Invalid Argument","trace":["acme:orders:agent/validate"],"type":"about:blank"} [err:<nil>]
+	//test: MarshalProblem() -> {"status":404,"title":"Not Found","type":"about:blank"} [err:<nil>]
+	//test: MarshalProblem() -> {"status":500,"title":"Internal Error","type":"about:blank"} [err:<nil>]
+
+}
+
+func ExampleParseProblem() {
+	s := NewStatus(StatusDeadlineExceeded, errors.New("upstream timeout")).SetLocation("acme:orders:agent/origin").SetLocation("acme:orders:link/timeout")
+	buf, _ := s.MarshalProblem()
+	s2, err := ParseProblem(buf)
+	fmt.Printf("test: ParseProblem() -> [%v] [code:%v] [trace:%v] [is:%v] [err:%v]\n", s2, s2.Code, s2.Trace, errors.Is(s2, s), err)
+
+	buf = []byte(`{"type":"https://example.com/probs/out-of-credit","title":"You do not have enough credit.","status":403,"detail":"Your current balance is 30, but that costs 50.","instance":"/account/12345/msgs/abc","balance":30}`)
+	p := new(Problem)
+	err = p.UnmarshalJSON(buf)
+	fmt.Printf("test: Problem.UnmarshalJSON() -> [type:%v] [status:%v] [instance:%v] [ext:%v] [err:%v]\n", p.Type, p.Status, p.Instance, p.Extensions, err)
+
+	_, err = ParseProblem([]byte("{"))
+	fmt.Printf("test: ParseProblem() -> [err:%v]\n", err)
+
+	//Output:
+	//test: ParseProblem() -> [Deadline Exceeded] [code:4] [trace:[acme:orders:agent/origin acme:orders:link/timeout]] [is:true] [err:<nil>]
+	//test: Problem.UnmarshalJSON() -> [type:https://example.com/probs/out-of-credit] [status:403] [instance:/account/12345/msgs/abc] [ext:map[balance:30]] [err:<nil>]
+	//test: ParseProblem() -> [err:JSON unmarshalling unexpected end of JSON input]
+
+}
@@ -1,10 +1,6 @@
 package core
 
-import (
-	"fmt"
-	"net/http"
-	"reflect"
-)
+import "net/http"
 
 // Micro-REST
 
@@ -19,31 +15,16 @@ type Chainable[T any] interface {
 	Link(t T) T
 }
 
-// BuildNetwork - build network
+// BuildNetwork - build network, panic on a nil or invalid type operative. Use NewNetwork for an error.
 func BuildNetwork(operatives []any) Exchange {
 	return buildNetwork[Exchange, Chainable[Exchange]](operatives)
 }
 
 // buildNetwork - build a chain of links - panic on nil or invalid type links
 func buildNetwork[T any, U Chainable[T]](operatives []any) (head T) {
-	if len(operatives) == 0 {
-		panic("operatives list is nil")
-	}
-	for i := len(operatives) - 1; i >= 0; i-- {
-		if operatives[i] == nil {
-			panic(fmt.Sprintf("operative is nil at index: %v", i))
-		}
-		// Check for a next function
-		if fn, ok := operatives[i].(func(next T) T); ok {
-			head = fn(head)
-			continue
-		}
-		// Check for a Chainable interface
-		if c, ok := operatives[i].(U); ok {
-			head = c.Link(head)
-			continue
-		}
-		panic(fmt.Sprintf("invalid operative type: %v", reflect.TypeOf(operatives[i])))
+	head, _, err := linkNetwork[T, U](operatives)
+	if err != nil {
+		panic(err.Error())
 	}
 	return head
 }
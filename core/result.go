@@ -0,0 +1,171 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Result - a value, a status, and the response metadata
+type Result[T any] struct {
+	Value  T
+	Status *Status
+	Header http.Header
+}
+
+// NewResult - create a successful result
+func NewResult[T any](v T, h http.Header) Result[T] {
+	return Result[T]{Value: v, Status: StatusOK, Header: h}
+}
+
+// NewErrorResult - create a result from an error, the status is the first *Status in the error chain, or
+// an internal error status
+func NewErrorResult[T any](err error) Result[T] {
+	var r Result[T]
+	if err == nil {
+		r.Status = StatusOK
+		return r
+	}
+	if !errors.As(err, &r.Status) {
+		r.Status = NewStatus(http.StatusInternalServerError, err)
+	}
+	return r
+}
+
+// NewResultFromResponse - create a result from the response of an Exchange. A successful response body is
+// decoded with New, a body without a content type is opaque bytes, an application/problem+json body is parsed
+// into the status, and the body is closed, unless the value is a reader of the body.
+func NewResultFromResponse[T any](resp *http.Response, err error) Result[T] {
+	if resp == nil {
+		if err == nil {
+			err = errors.New("response is nil")
+		}
+		return NewErrorResult[T](err)
+	}
+	keepBody := false
+	defer func() {
+		if resp.Body != nil && !keepBody {
+			resp.Body.Close()
+		}
+	}()
+	if err != nil {
+		r := Result[T]{Header: resp.Header}
+		if !errors.As(err, &r.Status) {
+			code := http.StatusInternalServerError
+			if resp.StatusCode >= http.StatusBadRequest {
+				code = resp.StatusCode
+			}
+			r.Status = NewStatus(code, err)
+		}
+		return r
+	}
+	r := Result[T]{Header: resp.Header}
+	if resp.StatusCode >= http.StatusBadRequest {
+		r.Status = responseStatus(resp)
+		return r
+	}
+	r.Status = NewStatus(resp.StatusCode, nil)
+	if resp.Body == nil || resp.Body == http.NoBody || resp.ContentLength == 0 {
+		return r
+	}
+	ct := resp.Header.Get(contentTypeHeader)
+	if ct == "" {
+		// RFC 9110 section 8.3, content without a type is treated as application/octet-stream
+		ct = ContentTypeBinary
+		if p, ok := any(&r.Value).(*string); ok {
+			var buf []byte
+			if buf, err = io.ReadAll(resp.Body); err != nil {
+				r.Status = NewStatus(StatusIOError, err)
+				return r
+			}
+			*p = string(buf)
+			return r
+		}
+	}
+	r.Value, err = New[T](&Content{Type: ct, Value: resp.Body})
+	if err != nil {
+		var s *Status
+		if !errors.As(err, &s) {
+			s = NewStatus(StatusInvalidContent, err)
+		}
+		r.Status = s
+		return r
+	}
+	_, keepBody = any(r.Value).(io.Reader)
+	return r
+}
+
+// OK - the result has a value
+func (r Result[T]) OK() bool {
+	return r.Err() == nil
+}
+
+// Err - the status as an error, or nil if successful. A status is successful if it has no error and the
+// HttpCode of the status code is not an error, as with StatusCode.
+func (r Result[T]) Err() error {
+	if r.Status == nil || HttpCode(r.Status.Code) < http.StatusBadRequest && r.Status.Err == nil {
+		return nil
+	}
+	return r.Status
+}
+
+// ContentType - the Content-Type header
+func (r Result[T]) ContentType() string {
+	return r.Header.Get(contentTypeHeader)
+}
+
+// StatusCode - the HTTP status code, from HttpCode
+func (r Result[T]) StatusCode() int {
+	if r.Status == nil {
+		return http.StatusOK
+	}
+	return HttpCode(r.Status.Code)
+}
+
+// OrElse - the value, or v if the result is not successful
+func (r Result[T]) OrElse(v T) T {
+	if r.OK() {
+		return r.Value
+	}
+	return v
+}
+
+// Content - the value as content, or the status if the result is not successful
+func (r Result[T]) Content() any {
+	if r.OK() {
+		return r.Value
+	}
+	return r.Status
+}
+
+// Map - map a successful result value, an unsuccessful result or a map error is returned as a status
+func Map[T, U any](r Result[T], fn func(T) (U, error)) Result[U] {
+	if !r.OK() {
+		return Result[U]{Status: r.Status, Header: r.Header}
+	}
+	v, err := fn(r.Value)
+	if err != nil {
+		u := NewErrorResult[U](err)
+		u.Header = r.Header
+		return u
+	}
+	return Result[U]{Value: v, Status: r.Status, Header: r.Header}
+}
+
+// responseStatus - status of an error response, from an application/problem+json body or the body text
+func responseStatus(resp *http.Response) *Status {
+	var buf []byte
+	if resp.Body != nil {
+		buf, _ = io.ReadAll(resp.Body)
+	}
+	if MediaType(resp.Header.Get(contentTypeHeader)) == ContentTypeProblemJson {
+		if s, err := ParseProblem(buf); err == nil {
+			return s
+		}
+	}
+	if len(buf) > 0 {
+		return NewStatus(resp.StatusCode, errors.New(string(buf)))
+	}
+	return NewStatus(resp.StatusCode, errors.New(fmt.Sprintf("response status code: %v", resp.StatusCode)))
+}
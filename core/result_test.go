@@ -0,0 +1,102 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+type resultAddress struct {
+	City  string `json:"city"`
+	State string `json:"state"`
+}
+
+func newTestResponse(code int, contentType, body string) *http.Response {
+	return &http.Response{StatusCode: code, Header: http.Header{"Content-Type": {contentType}}, ContentLength: int64(len(body)), Body: io.NopCloser(strings.NewReader(body))}
+}
+
+func ExampleNewResultFromResponse() {
+	r := NewResultFromResponse[resultAddress](newTestResponse(http.StatusOK, ContentTypeJson, `{"city":"Frisco","state":"TX"}`), nil)
+	fmt.Printf("test: NewResultFromResponse() -> %+v [ok:%v] [status:%v] [content-type:%v]\n", r.Value, r.OK(), r.StatusCode(), r.ContentType())
+
+	r = NewResultFromResponse[resultAddress](newTestResponse(http.StatusNotFound, ContentTypeProblemJson, `{"type":"about:blank","title":"Not Found","status":404,"detail":"address not found"}`), nil)
+	fmt.Printf("test: NewResultFromResponse() -> [ok:%v] [status:%v] [err:%v]\n", r.OK(), r.StatusCode(), r.Err())
+
+	r = NewResultFromResponse[resultAddress](newTestResponse(http.StatusOK, ContentTypeJson, `{"city":`), nil)
+	fmt.Printf("test: NewResultFromResponse() -> [ok:%v] [code:%v]\n", r.OK(), r.Status.Code)
+
+	r = NewResultFromResponse[resultAddress](&http.Response{StatusCode: http.StatusGatewayTimeout}, NewStatus(StatusDeadlineExceeded, errors.New("timeout")))
+	fmt.Printf("test: NewResultFromResponse() -> [ok:%v] [status:%v] [err:%v]\n", r.OK(), r.StatusCode(), r.Err())
+
+	r = Result[resultAddress]{Status: NewStatus(StatusUnavailable, nil)}
+	fmt.Printf("test: Result{StatusUnavailable} -> [ok:%v] [status:%v] [err:%v]\n", r.OK(), r.StatusCode(), r.Err())
+
+	r = Result[resultAddress]{Status: new(Status)}
+	fmt.Printf("test: Result{new(Status)} -> [ok:%v] [status:%v] [err:%v]\n", r.OK(), r.StatusCode(), r.Err() != nil)
+
+	//Output:
+	//test: NewResultFromResponse() -> {City:Frisco State:TX} [ok:true] [status:200] [content-type:application/json]
+	//test: NewResultFromResponse() -> [ok:false] [status:404] [err:Not Found - address not found]
+	//test: NewResultFromResponse() -> [ok:false] [code:90]
+	//test: NewResultFromResponse() -> [ok:false] [status:504] [err:Deadline Exceeded - timeout]
+	//test: Result{StatusUnavailable} -> [ok:false] [status:503] [err:Unavailable]
+	//test: Result{new(Status)} -> [ok:false] [status:500] [err:true]
+
+}
+
+func ExampleNewResultFromResponse_reader() {
+	body := &closeReader{Reader: strings.NewReader("streamed content")}
+	resp := &http.Response{StatusCode: http.StatusOK, Header: http.Header{"Content-Type": {ContentTypeText}}, ContentLength: -1, Body: body}
+	r := NewResultFromResponse[io.Reader](resp, nil)
+	buf, _ := io.ReadAll(r.Value)
+	fmt.Printf("test: NewResultFromResponse[io.Reader]() -> [%v] [ok:%v] [closed:%v]\n", string(buf), r.OK(), body.closed)
+
+	body = &closeReader{Reader: strings.NewReader("streamed content")}
+	resp = &http.Response{StatusCode: http.StatusOK, Header: http.Header{"Content-Type": {ContentTypeText}}, ContentLength: -1, Body: body}
+	s := NewResultFromResponse[string](resp, nil)
+	fmt.Printf("test: NewResultFromResponse[string]() -> [%v] [ok:%v] [closed:%v]\n", s.Value, s.OK(), body.closed)
+
+	for _, s := range []string{"string", "[]byte", "resultAddress"} {
+		resp = &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, ContentLength: 5, Body: io.NopCloser(strings.NewReader("bytes"))}
+		switch s {
+		case "string":
+			r := NewResultFromResponse[string](resp, nil)
+			fmt.Printf("test: NewResultFromResponse[%v]() -> [%v] [ok:%v]\n", s, r.Value, r.OK())
+		case "[]byte":
+			r := NewResultFromResponse[[]byte](resp, nil)
+			fmt.Printf("test: NewResultFromResponse[%v]() -> [%s] [ok:%v]\n", s, r.Value, r.OK())
+		default:
+			r := NewResultFromResponse[resultAddress](resp, nil)
+			fmt.Printf("test: NewResultFromResponse[%v]() -> [ok:%v] [err:%v]\n", s, r.OK(), r.Err())
+		}
+	}
+
+	//Output:
+	//test: NewResultFromResponse[io.Reader]() -> [streamed content] [ok:true] [closed:false]
+	//test: NewResultFromResponse[string]() -> [streamed content] [ok:true] [closed:true]
+	//test: NewResultFromResponse[string]() -> [bytes] [ok:true]
+	//test: NewResultFromResponse[[]byte]() -> [bytes] [ok:true]
+	//test: NewResultFromResponse[resultAddress]() -> [ok:false] [err:Invalid Content - content type: application/octet-stream is invalid, no codec is registered]
+
+}
+
+func ExampleMap_result() {
+	r := NewResult("123", nil)
+	n := Map(r, strconv.Atoi)
+	fmt.Printf("test: Map() -> %v [ok:%v]\n", n.Value, n.OK())
+
+	n = Map(NewResult("abc", nil), strconv.Atoi)
+	fmt.Printf("test: Map() -> [ok:%v] [code:%v] [or-else:%v]\n", n.OK(), n.Status.Code, n.OrElse(-1))
+
+	s := Map(NewErrorResult[string](NewStatus(StatusInvalidArgument, errors.New("id is invalid"))), func(s string) (int, error) { return len(s), nil })
+	fmt.Printf("test: Map() -> [ok:%v] [status:%v] [err:%v]\n", s.OK(), s.StatusCode(), s.Err())
+
+	//Output:
+	//test: Map() -> 123 [ok:true]
+	//test: Map() -> [ok:false] [code:500] [or-else:-1]
+	//test: Map() -> [ok:false] [status:400] [err:Invalid Argument - id is invalid]
+
+}
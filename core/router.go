@@ -0,0 +1,155 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Linker - optional interface for an Endpoint to list its network links
+type Linker interface {
+	Links() []Link
+}
+
+// RouteInfo - a mounted endpoint, as listed by the introspection endpoint
+type RouteInfo struct {
+	Pattern string `json:"pattern"`
+	Links   []Link `json:"links,omitempty"`
+}
+
+// Router - mount endpoints on a pattern aware http.ServeMux, https://pkg.go.dev/net/http#hdr-Patterns
+type Router struct {
+	mu     sync.Mutex
+	mux    *http.ServeMux
+	routes map[string]RouteInfo
+}
+
+// RouterGroup - endpoints with a shared pattern prefix and shared operatives
+type RouterGroup struct {
+	router     *Router
+	prefix     string
+	operatives []any
+}
+
+// NewRouter - create a router
+func NewRouter() *Router {
+	r := new(Router)
+	r.mux = http.NewServeMux()
+	r.routes = make(map[string]RouteInfo)
+	return r
+}
+
+// ServeHTTP - serve a request
+func (r *Router) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mux.ServeHTTP(w, req)
+}
+
+// Mount - mount endpoints, returning an error on a duplicate or conflicting pattern. Endpoints after an
+// error are not mounted.
+func (r *Router) Mount(endpoints ...Endpoint) error {
+	for _, e := range endpoints {
+		if e == nil {
+			return errors.New("router endpoint is nil")
+		}
+		var links []Link
+		if l, ok := e.(Linker); ok {
+			links = l.Links()
+		}
+		if err := r.handle(e.Pattern(), e, links); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Routes - mounted routes, sorted by pattern
+func (r *Router) Routes() []RouteInfo {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	list := make([]RouteInfo, 0, len(r.routes))
+	for _, info := range r.routes {
+		list = append(list, info)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Pattern < list[j].Pattern })
+	return list
+}
+
+// Introspect - mount an endpoint that lists every route pattern and its network links as JSON
+func (r *Router) Introspect(pattern string) error {
+	return r.handle(pattern, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		buf, err := json.Marshal(struct {
+			Routes []RouteInfo `json:"routes"`
+		}{Routes: r.Routes()})
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set(contentTypeHeader, ContentTypeJson)
+		w.Write(buf)
+	}), nil)
+}
+
+// Group - create a group, pattern paths are prefixed, and the operatives precede the endpoint operatives
+func (r *Router) Group(prefix string, operatives ...any) *RouterGroup {
+	return &RouterGroup{router: r, prefix: strings.TrimSuffix(prefix, "/"), operatives: operatives}
+}
+
+func (r *Router) handle(pattern string, h http.Handler, links []Link) (err error) {
+	if pattern == "" {
+		return errors.New("router pattern is empty")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.routes[pattern]; ok {
+		return errors.New(fmt.Sprintf("router pattern is a duplicate: [%v]", pattern))
+	}
+	// http.ServeMux panics on an invalid or conflicting pattern
+	defer func() {
+		if v := recover(); v != nil {
+			err = errors.New(fmt.Sprintf("router pattern is invalid or conflicts with a mounted pattern: %v", v))
+		}
+	}()
+	r.mux.Handle(pattern, h)
+	r.routes[pattern] = RouteInfo{Pattern: pattern, Links: links}
+	return nil
+}
+
+// Group - create a nested group
+func (g *RouterGroup) Group(prefix string, operatives ...any) *RouterGroup {
+	return &RouterGroup{router: g.router, prefix: g.prefix + strings.TrimSuffix(prefix, "/"), operatives: append(append([]any(nil), g.operatives...), operatives...)}
+}
+
+// Mount - create and mount an endpoint, the group prefix is added to the pattern path and the group operatives
+// precede the configured operatives
+func (g *RouterGroup) Mount(cfg EndpointConfig) error {
+	cfg.Pattern = prefixPattern(g.prefix, cfg.Pattern)
+	cfg.Operatives = append(append([]any(nil), g.operatives...), cfg.Operatives...)
+	e, err := NewEndpointWithConfig(cfg)
+	if err != nil {
+		return errors.New(fmt.Sprintf("router pattern [%v]: %v", cfg.Pattern, err))
+	}
+	return g.router.Mount(e)
+}
+
+// prefixPattern - add a prefix to the path of a [METHOD ][HOST]/[PATH] pattern
+func prefixPattern(prefix, pattern string) string {
+	if prefix == "" {
+		return pattern
+	}
+	method, rest, ok := strings.Cut(pattern, " ")
+	if !ok {
+		method, rest = "", pattern
+	} else {
+		method += " "
+		rest = strings.TrimLeft(rest, " \t")
+	}
+	i := strings.Index(rest, "/")
+	if i < 0 {
+		return method + rest + prefix
+	}
+	return method + rest[:i] + prefix + rest[i:]
+}
@@ -0,0 +1,77 @@
+package core
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+)
+
+func ExampleNewRouter() {
+	handler := func(w http.ResponseWriter, req *http.Request, resp *http.Response) {
+		w.WriteHeader(resp.StatusCode)
+	}
+	r := NewRouter()
+	err := r.Mount(NewEndpoint("GET /search", handler, nil, []any{statusExchangeFn("search", http.StatusOK)}))
+	fmt.Printf("test: Mount(\"GET /search\") -> [err:%v]\n", err)
+
+	err = r.Mount(NewEndpoint("GET /search", handler, nil, []any{do1ExchangeFn}))
+	fmt.Printf("test: Mount(\"GET /search\") -> [err:%v]\n", err)
+
+	err = r.Mount(NewEndpoint("GET /search/", handler, nil, []any{do1ExchangeFn}))
+	fmt.Printf("test: Mount(\"GET /search/\") -> [err:%v]\n", err)
+
+	err = r.Mount(NewEndpoint("GET /{resource}/1", handler, nil, []any{do1ExchangeFn}))
+	fmt.Printf("test: Mount(\"GET /{resource}/1\") -> [err:%v]\n", err != nil)
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/search", nil))
+	fmt.Printf("test: ServeHTTP(\"/search\") -> [status:%v]\n", rec.Code)
+
+	rec = httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/search", nil))
+	fmt.Printf("test: ServeHTTP(\"POST /search\") -> [status:%v]\n", rec.Code)
+
+	//Output:
+	//test: Mount("GET /search") -> [err:<nil>]
+	//test: Mount("GET /search") -> [err:router pattern is a duplicate: [GET /search]]
+	//test: Mount("GET /search/") -> [err:<nil>]
+	//test: Mount("GET /{resource}/1") -> [err:true]
+	//test: search-Exchange() -> request
+	//test: ServeHTTP("/search") -> [status:200]
+	//test: ServeHTTP("POST /search") -> [status:405]
+
+}
+
+func ExampleRouter_Group() {
+	handler := func(w http.ResponseWriter, req *http.Request, resp *http.Response) {
+		w.WriteHeader(resp.StatusCode)
+	}
+	r := NewRouter()
+	api := r.Group("/api/", Named("auth", statusExchangeFn("auth", http.StatusUnauthorized)))
+	v1 := api.Group("/v1", Named("log", statusExchangeFn("log", http.StatusOK)))
+	err := v1.Mount(EndpointConfig{Pattern: "GET /orders/{id}", Handler: handler, Operatives: []any{Named("orders", statusExchangeFn("orders", http.StatusOK))}})
+	fmt.Printf("test: Mount(\"GET /orders/{id}\") -> [err:%v]\n", err)
+	err = r.Introspect("GET /debug/routes")
+	fmt.Printf("test: Introspect() -> [err:%v]\n", err)
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/orders/123", nil))
+	fmt.Printf("test: ServeHTTP(\"/api/v1/orders/123\") -> [status:%v]\n", rec.Code)
+
+	rec = httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/routes", nil))
+	fmt.Printf("test: ServeHTTP(\"/debug/routes\") -> %v\n", rec.Body.String())
+
+	fmt.Printf("test: prefixPattern() -> %v\n", prefixPattern("/api", "POST example.com/orders"))
+
+	//Output:
+	//test: Mount("GET /orders/{id}") -> [err:<nil>]
+	//test: Introspect() -> [err:<nil>]
+	//test: auth-Exchange() -> request
+	//test: log-Exchange() -> request
+	//test: orders-Exchange() -> request
+	//test: ServeHTTP("/api/v1/orders/123") -> [status:200]
+	//test: ServeHTTP("/debug/routes") -> {"routes":[{"pattern":"GET /api/v1/orders/{id}","links":[{"index":0,"name":"auth","type":"func"},{"index":1,"name":"log","type":"func"},{"index":2,"name":"orders","type":"func"}]},{"pattern":"GET /debug/routes"}]}
+	//test: prefixPattern() -> POST example.com/api/orders
+
+}
@@ -0,0 +1,311 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	SchemaTypeObject  = "object"
+	SchemaTypeArray   = "array"
+	SchemaTypeString  = "string"
+	SchemaTypeNumber  = "number"
+	SchemaTypeInteger = "integer"
+	SchemaTypeBoolean = "boolean"
+	SchemaTypeNull    = "null"
+
+	problemViolations = "violations"
+)
+
+var (
+	contentSchemas = NewSyncMap[string, *Schema]()
+	typeSchemas    = NewSyncMap[reflect.Type, *Schema]()
+)
+
+// SchemaType - a JSON Schema type, either a single type or a list of types
+type SchemaType []string
+
+// UnmarshalJSON - a type is a string or an array of strings
+func (t *SchemaType) UnmarshalJSON(buf []byte) error {
+	var s string
+	if err := json.Unmarshal(buf, &s); err == nil {
+		*t = SchemaType{s}
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(buf, &list); err != nil {
+		return errors.New(fmt.Sprintf("schema type is not a string or an array of strings: %v", string(buf)))
+	}
+	*t = list
+	return nil
+}
+
+// Schema - JSON Schema draft 2020-12 subset: type, required, properties, enum, minimum, maximum, minLength,
+// maxLength, minItems, maxItems, pattern and items. Patterns are Go regular expressions.
+type Schema struct {
+	Type       SchemaType         `json:"type,omitempty"`
+	Required   []string           `json:"required,omitempty"`
+	Properties map[string]*Schema `json:"properties,omitempty"`
+	Enum       []any              `json:"enum,omitempty"`
+	Minimum    *float64           `json:"minimum,omitempty"`
+	Maximum    *float64           `json:"maximum,omitempty"`
+	MinLength  *int               `json:"minLength,omitempty"`
+	MaxLength  *int               `json:"maxLength,omitempty"`
+	MinItems   *int               `json:"minItems,omitempty"`
+	MaxItems   *int               `json:"maxItems,omitempty"`
+	Pattern    string             `json:"pattern,omitempty"`
+	Items      *Schema            `json:"items,omitempty"`
+
+	re *regexp.Regexp
+}
+
+// Violation - a schema violation, the path is a JSON Pointer to the invalid value
+type Violation struct {
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+// ValidationError - schema violations
+type ValidationError struct {
+	Violations []Violation
+}
+
+func (e *ValidationError) Error() string {
+	var sb strings.Builder
+	for i, v := range e.Violations {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(fmt.Sprintf("%v: %v", v.pointer(), v.Message))
+	}
+	return sb.String()
+}
+
+func (v Violation) pointer() string {
+	if v.Path == "" {
+		return "/"
+	}
+	return v.Path
+}
+
+// NewSchema - parse and compile a JSON Schema
+func NewSchema(buf []byte) (*Schema, error) {
+	s := new(Schema)
+	if err := json.Unmarshal(buf, s); err != nil {
+		return nil, errors.New(fmt.Sprintf("JSON unmarshalling %v", err))
+	}
+	if err := s.compile(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// RegisterSchema - register a schema for a content type, used by Unmarshal to validate JSON content
+func RegisterSchema(contentType string, s *Schema) error {
+	if s == nil {
+		return errors.New("schema is nil")
+	}
+	if err := s.compile(); err != nil {
+		return err
+	}
+	contentSchemas.Store(MediaType(contentType), s)
+	return nil
+}
+
+// RegisterTypeSchema - register a schema for a Go type, used by Unmarshal to validate JSON content. A type
+// schema has precedence over a content type schema.
+func RegisterTypeSchema[T any](s *Schema) error {
+	if s == nil {
+		return errors.New("schema is nil")
+	}
+	if err := s.compile(); err != nil {
+		return err
+	}
+	typeSchemas.Store(reflect.TypeOf((*T)(nil)).Elem(), s)
+	return nil
+}
+
+// Validate - validate a value decoded from JSON, as by json.Unmarshal into an any
+func (s *Schema) Validate(v any) []Violation {
+	var list []Violation
+	s.validate("", v, &list)
+	return list
+}
+
+// ValidateJSON - validate JSON content, returning a *Status with StatusInvalidContent, which HttpCode maps to
+// 422 Unprocessable Entity, and a *ValidationError if there are violations
+func (s *Schema) ValidateJSON(buf []byte) error {
+	var v any
+	if err := json.Unmarshal(buf, &v); err != nil {
+		return NewStatus(StatusJsonDecodeError, errors.New(fmt.Sprintf("JSON unmarshalling %v", err)))
+	}
+	if list := s.Validate(v); len(list) > 0 {
+		return NewStatus(StatusInvalidContent, &ValidationError{Violations: list})
+	}
+	return nil
+}
+
+func (s *Schema) compile() error {
+	if s.Pattern != "" && s.re == nil {
+		re, err := regexp.Compile(s.Pattern)
+		if err != nil {
+			return errors.New(fmt.Sprintf("schema pattern is invalid: %v", err))
+		}
+		s.re = re
+	}
+	for _, p := range s.Properties {
+		if p == nil {
+			continue
+		}
+		if err := p.compile(); err != nil {
+			return err
+		}
+	}
+	if s.Items != nil {
+		return s.Items.compile()
+	}
+	return nil
+}
+
+func (s *Schema) validate(path string, v any, list *[]Violation) {
+	if len(s.Type) > 0 && !s.validType(v) {
+		*list = append(*list, Violation{Path: path, Message: fmt.Sprintf("type %v is not of schema type %v", jsonType(v), strings.Join(s.Type, ", "))})
+		return
+	}
+	if len(s.Enum) > 0 && !s.validEnum(v) {
+		*list = append(*list, Violation{Path: path, Message: fmt.Sprintf("value %v is not in enum", jsonValue(v))})
+	}
+	switch t := v.(type) {
+	case map[string]any:
+		for _, name := range s.Required {
+			if _, ok := t[name]; !ok {
+				*list = append(*list, Violation{Path: path, Message: fmt.Sprintf("required property %v is missing", name)})
+			}
+		}
+		names := make([]string, 0, len(s.Properties))
+		for name := range s.Properties {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if value, ok := t[name]; ok && s.Properties[name] != nil {
+				s.Properties[name].validate(path+"/"+escapePointer(name), value, list)
+			}
+		}
+	case []any:
+		if s.MinItems != nil && len(t) < *s.MinItems {
+			*list = append(*list, Violation{Path: path, Message: fmt.Sprintf("item count %v is less than minItems %v", len(t), *s.MinItems)})
+		}
+		if s.MaxItems != nil && len(t) > *s.MaxItems {
+			*list = append(*list, Violation{Path: path, Message: fmt.Sprintf("item count %v is greater than maxItems %v", len(t), *s.MaxItems)})
+		}
+		if s.Items != nil {
+			for i, item := range t {
+				s.Items.validate(path+"/"+strconv.Itoa(i), item, list)
+			}
+		}
+	case string:
+		n := len([]rune(t))
+		if s.MinLength != nil && n < *s.MinLength {
+			*list = append(*list, Violation{Path: path, Message: fmt.Sprintf("length %v is less than minLength %v", n, *s.MinLength)})
+		}
+		if s.MaxLength != nil && n > *s.MaxLength {
+			*list = append(*list, Violation{Path: path, Message: fmt.Sprintf("length %v is greater than maxLength %v", n, *s.MaxLength)})
+		}
+		if s.Pattern != "" && !s.matchPattern(t) {
+			*list = append(*list, Violation{Path: path, Message: fmt.Sprintf("value %q does not match pattern %v", t, s.Pattern)})
+		}
+	case float64:
+		if s.Minimum != nil && t < *s.Minimum {
+			*list = append(*list, Violation{Path: path, Message: fmt.Sprintf("value %v is less than minimum %v", t, *s.Minimum)})
+		}
+		if s.Maximum != nil && t > *s.Maximum {
+			*list = append(*list, Violation{Path: path, Message: fmt.Sprintf("value %v is greater than maximum %v", t, *s.Maximum)})
+		}
+	}
+}
+
+func (s *Schema) validType(v any) bool {
+	got := jsonType(v)
+	for _, t := range s.Type {
+		if t == got || t == SchemaTypeNumber && got == SchemaTypeInteger {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Schema) validEnum(v any) bool {
+	buf, _ := json.Marshal(v)
+	for _, e := range s.Enum {
+		buf2, _ := json.Marshal(e)
+		if string(buf) == string(buf2) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Schema) matchPattern(v string) bool {
+	if s.re != nil {
+		return s.re.MatchString(v)
+	}
+	ok, err := regexp.MatchString(s.Pattern, v)
+	return ok && err == nil
+}
+
+// jsonType - the JSON Schema type of a value, integer is used for whole numbers
+func jsonType(v any) string {
+	switch t := v.(type) {
+	case nil:
+		return SchemaTypeNull
+	case bool:
+		return SchemaTypeBoolean
+	case string:
+		return SchemaTypeString
+	case float64:
+		if t == math.Trunc(t) && !math.IsInf(t, 0) {
+			return SchemaTypeInteger
+		}
+		return SchemaTypeNumber
+	case []any:
+		return SchemaTypeArray
+	case map[string]any:
+		return SchemaTypeObject
+	}
+	return fmt.Sprintf("%T", v)
+}
+
+func jsonValue(v any) string {
+	buf, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(buf)
+}
+
+func escapePointer(s string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(s, "~", "~0"), "/", "~1")
+}
+
+// lookupSchema - a type schema has precedence over a content type schema
+func lookupSchema(t reflect.Type, contentType string) *Schema {
+	if s, ok := typeSchemas.Load(t); ok {
+		return s
+	}
+	if s, ok := contentSchemas.Load(MediaType(contentType)); ok {
+		return s
+	}
+	return nil
+}
+
+func isJson(mediaType string) bool {
+	return mediaType == ContentTypeJson || strings.HasSuffix(mediaType, jsonSuffix)
+}
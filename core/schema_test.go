@@ -0,0 +1,94 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+type order struct {
+	Id       string   `json:"id"`
+	Quantity int      `json:"quantity"`
+	Status   string   `json:"status"`
+	Tags     []string `json:"tags"`
+}
+
+const orderSchema = `{
+	"type": "object",
+	"required": ["id", "quantity"],
+	"properties": {
+		"id": {"type": "string", "pattern": "^ord-[0-9]+$"},
+		"quantity": {"type": "integer", "minimum": 1, "maximum": 100},
+		"status": {"enum": ["open", "closed"]},
+		"tags": {"type": "array", "maxItems": 2, "items": {"type": "string", "minLength": 2}}
+	}
+}`
+
+func ExampleNewSchema() {
+	s, err := NewSchema([]byte(orderSchema))
+	fmt.Printf("test: NewSchema() -> [type:%v] [required:%v] [err:%v]\n", s.Type, s.Required, err)
+
+	_, err = NewSchema([]byte(`{"type":"string","pattern":"[a-"}`))
+	fmt.Printf("test: NewSchema() -> [err:%v]\n", err)
+
+	s, _ = NewSchema([]byte(`{"type":["string","null"]}`))
+	fmt.Printf("test: Validate() -> [null:%v] [number:%v]\n", s.Validate(nil), s.Validate(1.5))
+
+	//Output:
+	//test: NewSchema() -> [type:[object]] [required:[id quantity]] [err:<nil>]
+	//test: NewSchema() -> [err:schema pattern is invalid: error parsing regexp: missing closing ]: `[a-`]
+	//test: Validate() -> [null:[]] [number:[{ type number is not of schema type string, null}]]
+
+}
+
+func ExampleRegisterTypeSchema() {
+	s, _ := NewSchema([]byte(orderSchema))
+	err := RegisterTypeSchema[order](s)
+	fmt.Printf("test: RegisterTypeSchema[order]() -> [err:%v]\n", err)
+
+	o, err := Unmarshal[order](&Content{Type: ContentTypeJson, Value: []byte(`{"id":"ord-1","quantity":5,"status":"open","tags":["a1"]}`)})
+	fmt.Printf("test: Unmarshal[order]() -> [%v] [err:%v]\n", o, err)
+
+	_, err = New[order](&Content{Type: ContentTypeJson, Value: strings.NewReader(`{"id":"order-1","quantity":0.5,"status":"lost","tags":["a","b","c"]}`)})
+	var status *Status
+	var v *ValidationError
+	fmt.Printf("test: New[order]() -> [status:%v] [is:%v] [http:%v] [violations:%v]\n", errors.As(err, &status), errors.Is(err, NewStatus(StatusInvalidContent, nil)), HttpCode(status.Code), errors.As(err, &v))
+	for _, violation := range v.Violations {
+		fmt.Printf("test: Violation -> [%v] [%v]\n", violation.Path, violation.Message)
+	}
+	buf, _ := status.MarshalProblem()
+	fmt.Printf("test: MarshalProblem() -> %v\n", string(buf))
+
+	//Output:
+	//test: RegisterTypeSchema[order]() -> [err:<nil>]
+	//test: Unmarshal[order]() -> [{ord-1 5 open [a1]}] [err:<nil>]
+	//test: New[order]() -> [status:true] [is:true] [http:422] [violations:true]
+	//test: Violation -> [/id] [value "order-1" does not match pattern ^ord-[0-9]+$]
+	//test: Violation -> [/quantity] [type number is not of schema type integer]
+	//test: Violation -> [/status] [value "lost" is not in enum]
+	//test: Violation -> [/tags] [item count 3 is greater than maxItems 2]
+	//test: Violation -> [/tags/0] [length 1 is less than minLength 2]
+	//test: Violation -> [/tags/1] [length 1 is less than minLength 2]
+	//test: Violation -> [/tags/2] [length 1 is less than minLength 2]
+	//test: MarshalProblem() -> {"code":90,"detail":"/id: value \"order-1\" does not match pattern ^ord-[0-9]+$, /quantity: type number is not of schema type integer, /status: value \"lost\" is not in enum, /tags: item count 3 is greater than maxItems 2, /tags/0: length 1 is less than minLength 2, /tags/1: length 1 is less than minLength 2, /tags/2: length 1 is less than minLength 2","status":422,"title":"Invalid Content","type":"about:blank","violations":[{"path":"/id","message":"value \"order-1\" does not match pattern ^ord-[0-9]+$"},{"path":"/quantity","message":"type number is not of schema type integer"},{"path":"/status","message":"value \"lost\" is not in enum"},{"path":"/tags","message":"item count 3 is greater than maxItems 2"},{"path":"/tags/0","message":"length 1 is less than minLength 2"},{"path":"/tags/1","message":"length 1 is less than minLength 2"},{"path":"/tags/2","message":"length 1 is less than minLength 2"}]}
+
+}
+
+func ExampleRegisterSchema() {
+	s, _ := NewSchema([]byte(`{"type":"object","required":["name"]}`))
+	err := RegisterSchema("application/vnd.acme.config+json", s)
+	fmt.Printf("test: RegisterSchema() -> [err:%v]\n", err)
+
+	_, err = Unmarshal[map[string]any](&Content{Type: "application/vnd.acme.config+json; charset=utf-8", Value: []byte(`{"timeout":"5s"}`)})
+	fmt.Printf("test: Unmarshal[map[string]any]() -> [err:%v]\n", err)
+
+	// Not validated, as the content type is different
+	m, err1 := Unmarshal[map[string]any](&Content{Type: ContentTypeJson, Value: []byte(`{"timeout":"5s"}`)})
+	fmt.Printf("test: Unmarshal[map[string]any]() -> [%v] [err:%v]\n", m, err1)
+
+	//Output:
+	//test: RegisterSchema() -> [err:<nil>]
+	//test: Unmarshal[map[string]any]() -> [err:Invalid Content - /: required property name is missing]
+	//test: Unmarshal[map[string]any]() -> [map[timeout:5s]] [err:<nil>]
+
+}
@@ -0,0 +1,186 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/appellative-ai/common/iox"
+	"io/fs"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+var (
+	sequencer atomic.Pointer[Sequencer]
+)
+
+func init() {
+	sequencer.Store(NewSequencer(nil))
+}
+
+// SequenceStore - sequence persistence, a name that has not been stored has a value of 0
+type SequenceStore interface {
+	Load(name string) (int64, error)
+	Store(name string, value int64) error
+}
+
+// Sequencer - independent monotonic sequences per name. A value is only issued after it has been stored, so
+// sequences are gap-free across restarts.
+type Sequencer struct {
+	mu     sync.Mutex
+	store  SequenceStore
+	values map[string]int64
+}
+
+// NewSequencer - create a sequencer, a nil store keeps sequences in memory
+func NewSequencer(store SequenceStore) *Sequencer {
+	s := new(Sequencer)
+	s.store = store
+	s.values = make(map[string]int64)
+	return s
+}
+
+// Next - the next value of a sequence, starting at 1
+func (s *Sequencer) Next(name string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v, err := s.load(name)
+	if err != nil {
+		return 0, err
+	}
+	v++
+	if s.store != nil {
+		if err = s.store.Store(name, v); err != nil {
+			return 0, err
+		}
+	}
+	s.values[name] = v
+	return v, nil
+}
+
+// Peek - the last issued value of a sequence, 0 if no value has been issued
+func (s *Sequencer) Peek(name string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.load(name)
+}
+
+// Reset - reset a sequence, so the next value is 1
+func (s *Sequencer) Reset(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.store != nil {
+		if err := s.store.Store(name, 0); err != nil {
+			return err
+		}
+	}
+	s.values[name] = 0
+	return nil
+}
+
+// Versioned - add the next value of the name sequence as the fragment, a name that already has a fragment is
+// returned unchanged
+func (s *Sequencer) Versioned(name string) (string, error) {
+	base, fragment, _ := strings.Cut(name, Fragment)
+	if base == "" {
+		return "", errors.New("name is empty")
+	}
+	if fragment != "" {
+		return name, nil
+	}
+	v, err := s.Next(base)
+	if err != nil {
+		return "", err
+	}
+	return base + Fragment + fmt.Sprintf("%v", v), nil
+}
+
+func (s *Sequencer) load(name string) (int64, error) {
+	if v, ok := s.values[name]; ok {
+		return v, nil
+	}
+	if s.store == nil {
+		return 0, nil
+	}
+	v, err := s.store.Load(name)
+	if err != nil {
+		return 0, err
+	}
+	s.values[name] = v
+	return v, nil
+}
+
+// SetSequencer - set the sequencer used by Versioned
+func SetSequencer(s *Sequencer) {
+	if s != nil {
+		sequencer.Store(s)
+	}
+}
+
+// FileSequenceStore - sequences stored as a JSON object in a file, written with iox.WriteFile
+type FileSequenceStore struct {
+	mu     sync.Mutex
+	uri    string
+	values map[string]int64
+}
+
+// NewFileSequenceStore - create a file sequence store, the uri is a file:// uri as used by iox.FileName
+func NewFileSequenceStore(uri string) *FileSequenceStore {
+	s := new(FileSequenceStore)
+	s.uri = uri
+	return s
+}
+
+// Load - load a sequence value
+func (s *FileSequenceStore) Load(name string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.read(); err != nil {
+		return 0, err
+	}
+	return s.values[name], nil
+}
+
+// Store - store a sequence value, the file is rewritten
+func (s *FileSequenceStore) Store(name string, value int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.read(); err != nil {
+		return err
+	}
+	prev, ok := s.values[name]
+	s.values[name] = value
+	buf, err := json.Marshal(s.values)
+	if err == nil {
+		err = iox.WriteFile(s.uri, buf)
+	}
+	if err != nil {
+		if ok {
+			s.values[name] = prev
+		} else {
+			delete(s.values, name)
+		}
+		return err
+	}
+	return nil
+}
+
+// read - read the file once, a missing file has no sequences
+func (s *FileSequenceStore) read() error {
+	if s.values != nil {
+		return nil
+	}
+	buf, err := iox.ReadFile(s.uri)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	values := make(map[string]int64)
+	if len(buf) > 0 {
+		if err = json.Unmarshal(buf, &values); err != nil {
+			return errors.New(fmt.Sprintf("JSON unmarshalling %v", err))
+		}
+	}
+	s.values = values
+	return nil
+}
@@ -0,0 +1,67 @@
+package core
+
+import (
+	"fmt"
+	"os"
+)
+
+func ExampleNewSequencer() {
+	s := NewSequencer(nil)
+	agent := "common:core:agent/test"
+	config := "common:core:config/test"
+
+	v1, _ := s.Next(agent)
+	v2, _ := s.Next(agent)
+	v3, _ := s.Next(config)
+	fmt.Printf("test: Next() -> [agent:%v %v] [config:%v]\n", v1, v2, v3)
+
+	name, err := s.Versioned(agent)
+	fmt.Printf("test: Versioned() -> %v [err:%v]\n", name, err)
+
+	name, err = s.Versioned("acme:billing:agent/rater#1.4.2")
+	fmt.Printf("test: Versioned() -> %v [err:%v]\n", name, err)
+
+	peek, _ := s.Peek(agent)
+	fmt.Printf("test: Peek() -> %v\n", peek)
+
+	s.Reset(agent)
+	v1, _ = s.Next(agent)
+	fmt.Printf("test: Reset() -> [next:%v]\n", v1)
+
+	//Output:
+	//test: Next() -> [agent:1 2] [config:1]
+	//test: Versioned() -> common:core:agent/test#3 [err:<nil>]
+	//test: Versioned() -> acme:billing:agent/rater#1.4.2 [err:<nil>]
+	//test: Peek() -> 3
+	//test: Reset() -> [next:1]
+
+}
+
+func ExampleNewFileSequenceStore() {
+	// iox.FileName removes the leading slash of the path, so the file is relative to the working directory
+	f, _ := os.CreateTemp(".", "sequence-*.json")
+	f.Close()
+	defer os.Remove(f.Name())
+	uri := "file:///" + f.Name()[len("./"):]
+	name := "common:core:agent/test"
+
+	s := NewSequencer(NewFileSequenceStore(uri))
+	s.Next(name)
+	s.Next(name)
+
+	// Restart
+	s = NewSequencer(NewFileSequenceStore(uri))
+	peek, err := s.Peek(name)
+	fmt.Printf("test: Peek() -> %v [err:%v]\n", peek, err)
+	v, err := s.Next(name)
+	fmt.Printf("test: Next() -> %v [err:%v]\n", v, err)
+
+	buf, _ := os.ReadFile(f.Name())
+	fmt.Printf("test: ReadFile() -> %v\n", string(buf))
+
+	//Output:
+	//test: Peek() -> 2 [err:<nil>]
+	//test: Next() -> 3 [err:<nil>]
+	//test: ReadFile() -> {"common:core:agent/test":3}
+
+}
@@ -1,6 +1,7 @@
 package core
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 )
@@ -19,8 +20,26 @@ const (
 	StatusGzipEncodingError          = int(100) // Gzip encoding error
 	StatusGzipDecodingError          = int(101) // Gzip decoding error
 	StatusExecError                  = int(105) // Execution error, as in a database call
-	StatusInvalidArgument            = 3        //codes.InvalidArgument    // The client specified an invalid argument. Note that this differs from FAILED_PRECONDITION. INVALID_ARGUMENT indicates arguments that are problematic regardless of the state of the system (e.g., a malformed file name).
-	StatusDeadlineExceeded           = 4        //codes.DeadlineExceeded   // The deadline expired before the operation could complete. For operations that change the state of the system, this error may be returned even if the operation has completed successfully. For example, a successful response from a server could have been delayed long
+
+	// gRPC canonical codes, https://grpc.github.io/grpc/core/md_doc_statuscodes.html
+	// OK and NotFound are prefixed as the names are taken by the shared *Status values
+	StatusGrpcOK             = 0  // Not an error, returned on success, HttpCode maps the zero value to 500, see GrpcHttpCode
+	StatusCancelled          = 1  // The operation was cancelled, typically by the caller
+	StatusUnknown            = 2  // Unknown error, as in an error from an unknown error space, or an API that does not return enough error information
+	StatusInvalidArgument    = 3  // The client specified an invalid argument, regardless of the state of the system (e.g., a malformed file name)
+	StatusDeadlineExceeded   = 4  // The deadline expired before the operation could complete
+	StatusGrpcNotFound       = 5  // Some requested entity was not found
+	StatusAlreadyExists      = 6  // The entity that a client attempted to create already exists
+	StatusPermissionDenied   = 7  // The caller does not have permission to execute the specified operation
+	StatusResourceExhausted  = 8  // Some resource has been exhausted, perhaps a per-user quota, or the entire file system is out of space
+	StatusFailedPrecondition = 9  // The system is not in a state required for the operation's execution, the client should not retry until the state is fixed
+	StatusAborted            = 10 // The operation was aborted, typically due to a concurrency issue, the client should retry at a higher level
+	StatusOutOfRange         = 11 // The operation was attempted past the valid range, e.g., seeking or reading past end-of-file
+	StatusUnimplemented      = 12 // The operation is not implemented or is not supported/enabled in this service
+	StatusInternal           = 13 // Internal error, some invariant expected by the underlying system has been broken
+	StatusUnavailable        = 14 // The service is currently unavailable, the client can retry the failing call
+	StatusDataLoss           = 15 // Unrecoverable data loss or corruption
+	StatusUnauthenticated    = 16 // The request does not have valid authentication credentials for the operation
 )
 
 var (
@@ -28,10 +47,13 @@ var (
 	StatusNotFound = NewStatus(http.StatusNotFound, nil)
 )
 
+// Status - a code and error, with the locations the status travelled through. Location is the most recent
+// location, as set before Trace was added.
 type Status struct {
 	Err      error
 	Code     int
 	Location string
+	Trace    []string
 }
 
 func NewStatus(code int, err error) *Status {
@@ -51,18 +73,90 @@ func (s *Status) String() string {
 
 }
 
+// Error - error interface
+func (s *Status) Error() string {
+	return s.String()
+}
+
+// Unwrap - return the wrapped error
+func (s *Status) Unwrap() error {
+	return s.Err
+}
+
+// Is - errors.Is support, a *Status target matches on code
+func (s *Status) Is(target error) bool {
+	if t, ok := target.(*Status); ok && t != nil {
+		return s.Code == t.Code
+	}
+	return false
+}
+
+// SetLocation - append a location to the trace, the first location is the origin. The status is changed in
+// place, so use Location for a status that may be shared. The shared StatusOK and StatusNotFound values are never
+// traced.
 func (s *Status) SetLocation(location string) *Status {
-	s.Location = location
+	if location != "" && s != StatusOK && s != StatusNotFound {
+		s.Trace = append(s.Trace, location)
+		s.Location = location
+	}
 	return s
 }
 
-/*
-func (s *Status) Error() string {
-	if s.Err != nil {
-		return s.Err.Error()
+// Origin - the first location in the trace
+func (s *Status) Origin() string {
+	if len(s.Trace) == 0 {
+		return ""
+	}
+	return s.Trace[0]
+}
+
+// Location - return the error with a location appended to the trace of the first *Status in the error chain.
+// The *Status is copied and not changed, so a sentinel status, as in var ErrX = NewStatus(...), can be returned
+// by many requests. A *Status in a wrapped error is replaced by the copy for errors.As, and errors.Is still
+// matches on code.
+func Location(err error, location string) error {
+	if err == nil || location == "" {
+		return err
+	}
+	if s, ok := err.(*Status); ok {
+		return s.traced(location)
+	}
+	if t, ok := err.(*traceError); ok {
+		return &traceError{error: t.error, status: t.status.traced(location)}
 	}
-	return ""
+	var s *Status
+	if errors.As(err, &s) {
+		return &traceError{error: err, status: s.traced(location)}
+	}
+	return err
 }
 
+// traced - a copy of the status with a location appended to the trace
+func (s *Status) traced(location string) *Status {
+	if s == StatusOK || s == StatusNotFound {
+		return s
+	}
+	c := *s
+	c.Trace = append(s.Trace[:len(s.Trace):len(s.Trace)], location)
+	c.Location = location
+	return &c
+}
 
-*/
+// traceError - a wrapped error with a traced copy of the *Status in the error chain
+type traceError struct {
+	error
+	status *Status
+}
+
+func (t *traceError) Unwrap() error {
+	return t.error
+}
+
+// As - errors.As support, a **Status target is set to the traced copy
+func (t *traceError) As(target any) bool {
+	if p, ok := target.(**Status); ok {
+		*p = t.status
+		return true
+	}
+	return false
+}
@@ -21,3 +21,38 @@ func ExampleStatus() {
 	//test: NewStatus() -> Not Found
 
 }
+
+func ExampleStatus_Error() {
+	var err error = NewStatus(http.StatusNotFound, errors.New("resource not found"))
+	fmt.Printf("test: Error() -> [%v] [is-not-found:%v] [is-ok:%v]\n", err, errors.Is(err, StatusNotFound), errors.Is(err, StatusOK))
+
+	cause := errors.New("connection reset")
+	err = fmt.Errorf("exchange: %w", NewStatus(http.StatusServiceUnavailable, cause))
+	var s *Status
+	ok := errors.As(err, &s)
+	fmt.Printf("test: errors.As() -> [ok:%v] [code:%v] [is-cause:%v]\n", ok, s.Code, errors.Is(err, cause))
+
+	//Output:
+	//test: Error() -> [Not Found - resource not found] [is-not-found:true] [is-ok:false]
+	//test: errors.As() -> [ok:true] [code:503] [is-cause:true]
+
+}
+
+func ExampleStatus_Trace() {
+	s := NewStatus(http.StatusGatewayTimeout, nil).SetLocation("common:core:agent/origin")
+	err := Location(s, "common:core:link/retry")
+	err = Location(fmt.Errorf("wrapped: %w", err), "common:core:link/endpoint")
+	var t *Status
+	errors.As(err, &t)
+	fmt.Printf("test: Location() -> [origin:%v] [trace:%v] [is:%v]\n", t.Origin(), t.Trace, errors.Is(err, s))
+	fmt.Printf("test: Location() -> [unchanged:%v]\n", s.Trace)
+
+	StatusNotFound.SetLocation("common:core:link/shared")
+	fmt.Printf("test: SetLocation() -> [trace:%v]\n", StatusNotFound.Trace)
+
+	//Output:
+	//test: Location() -> [origin:common:core:agent/origin] [trace:[common:core:agent/origin common:core:link/retry common:core:link/endpoint]] [is:true]
+	//test: Location() -> [unchanged:[common:core:agent/origin]]
+	//test: SetLocation() -> [trace:[]]
+
+}
@@ -0,0 +1,242 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Version - semantic version, https://semver.org. Build metadata is retained but ignored in comparisons.
+type Version struct {
+	Major      int
+	Minor      int
+	Patch      int
+	PreRelease string
+	Build      string
+}
+
+// ParseVersion - parse a semantic version, a leading 'v' is optional, and a missing minor or patch is 0,
+// so a Versioned counter fragment of 17 is 17.0.0
+func ParseVersion(s string) (Version, error) {
+	var v Version
+	if s == "" {
+		return v, errors.New("version is empty")
+	}
+	t := strings.TrimPrefix(s, "v")
+	if i := strings.Index(t, "+"); i >= 0 {
+		v.Build = t[i+1:]
+		t = t[:i]
+		if err := validIdentifiers("build", v.Build, false); err != nil {
+			return Version{}, errors.New(fmt.Sprintf("%v: [%v]", err, s))
+		}
+	}
+	if i := strings.Index(t, "-"); i >= 0 {
+		v.PreRelease = t[i+1:]
+		t = t[:i]
+		if err := validIdentifiers("pre-release", v.PreRelease, true); err != nil {
+			return Version{}, errors.New(fmt.Sprintf("%v: [%v]", err, s))
+		}
+	}
+	parts := strings.Split(t, ".")
+	if len(parts) > 3 {
+		return Version{}, errors.New(fmt.Sprintf("version has too many components: [%v]", s))
+	}
+	for i, p := range parts {
+		n, err := parseNumeric(p)
+		if err != nil {
+			return Version{}, errors.New(fmt.Sprintf("version component is invalid, %v: [%v]", err, s))
+		}
+		switch i {
+		case 0:
+			v.Major = n
+		case 1:
+			v.Minor = n
+		case 2:
+			v.Patch = n
+		}
+	}
+	return v, nil
+}
+
+// String - MAJOR.MINOR.PATCH[-PRERELEASE][+BUILD]
+func (v Version) String() string {
+	s := fmt.Sprintf("%v.%v.%v", v.Major, v.Minor, v.Patch)
+	if v.PreRelease != "" {
+		s += "-" + v.PreRelease
+	}
+	if v.Build != "" {
+		s += "+" + v.Build
+	}
+	return s
+}
+
+// Compare - semantic version precedence, returns -1, 0 or +1
+func (v Version) Compare(v2 Version) int {
+	if c := compareInt(v.Major, v2.Major); c != 0 {
+		return c
+	}
+	if c := compareInt(v.Minor, v2.Minor); c != 0 {
+		return c
+	}
+	if c := compareInt(v.Patch, v2.Patch); c != 0 {
+		return c
+	}
+	return comparePreRelease(v.PreRelease, v2.PreRelease)
+}
+
+// Compatible - v can be used where v2 is required: same major version and not older, for a 0.x major
+// version the minor version must also be the same
+func (v Version) Compatible(v2 Version) bool {
+	if v.Major != v2.Major {
+		return false
+	}
+	if v.Major == 0 && v.Minor != v2.Minor {
+		return false
+	}
+	return v.Compare(v2) >= 0
+}
+
+// Version - semantic version of the fragment
+func (n Name) Version() (Version, error) {
+	return ParseVersion(n.Fragment)
+}
+
+// NameVersion - semantic version of a name fragment
+func NameVersion(name string) (Version, error) {
+	_, fragment, ok := strings.Cut(name, Fragment)
+	if !ok {
+		return Version{}, errors.New(fmt.Sprintf("name fragment is empty: [%v]", name))
+	}
+	return ParseVersion(fragment)
+}
+
+// LatestVersion - the name with the newest version among the names for the un-fragmented name. A stable version
+// is preferred, a pre-release is only selected when there is no stable version. Names without a valid version
+// fragment are ignored.
+func LatestVersion(names []string, name string) (string, bool) {
+	return selectVersion(names, name, func(v Version) bool { return true })
+}
+
+// CompatibleVersion - the name with the newest version compatible with the required version, among the
+// names for the un-fragmented name. As with LatestVersion, a stable version is preferred.
+func CompatibleVersion(names []string, name string, required Version) (string, bool) {
+	return selectVersion(names, name, func(v Version) bool { return v.Compatible(required) })
+}
+
+func selectVersion(names []string, name string, valid func(v Version) bool) (string, bool) {
+	base, _, _ := strings.Cut(name, Fragment)
+	found := ""
+	var latest Version
+	for _, n := range names {
+		b, fragment, ok := strings.Cut(n, Fragment)
+		if !ok || b != base {
+			continue
+		}
+		v, err := ParseVersion(fragment)
+		if err != nil || !valid(v) {
+			continue
+		}
+		if found == "" || newerVersion(v, latest) {
+			found = n
+			latest = v
+		}
+	}
+	return found, found != ""
+}
+
+// newerVersion - v is a stable version and latest is a pre-release, or v has a higher precedence and
+// the same stability
+func newerVersion(v, latest Version) bool {
+	if (v.PreRelease == "") != (latest.PreRelease == "") {
+		return v.PreRelease == ""
+	}
+	return v.Compare(latest) > 0
+}
+
+func parseNumeric(s string) (int, error) {
+	if s == "" {
+		return 0, errors.New("empty")
+	}
+	if len(s) > 1 && s[0] == '0' {
+		return 0, errors.New("leading zero")
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return 0, errors.New(fmt.Sprintf("%q", s))
+		}
+	}
+	return strconv.Atoi(s)
+}
+
+func validIdentifiers(field, s string, numeric bool) error {
+	for _, id := range strings.Split(s, ".") {
+		if id == "" {
+			return errors.New(fmt.Sprintf("version %v identifier is empty", field))
+		}
+		for _, r := range id {
+			if !(r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r == '-') {
+				return errors.New(fmt.Sprintf("version %v identifier has an invalid character %q", field, r))
+			}
+		}
+		if numeric && isNumeric(id) && len(id) > 1 && id[0] == '0' {
+			return errors.New(fmt.Sprintf("version %v identifier has a leading zero", field))
+		}
+	}
+	return nil
+}
+
+func comparePreRelease(a, b string) int {
+	// A version without a pre-release has a higher precedence
+	switch {
+	case a == b:
+		return 0
+	case a == "":
+		return 1
+	case b == "":
+		return -1
+	}
+	ids1 := strings.Split(a, ".")
+	ids2 := strings.Split(b, ".")
+	for i := 0; i < len(ids1) && i < len(ids2); i++ {
+		if c := compareIdentifier(ids1[i], ids2[i]); c != 0 {
+			return c
+		}
+	}
+	return compareInt(len(ids1), len(ids2))
+}
+
+func compareIdentifier(a, b string) int {
+	n1, n2 := isNumeric(a), isNumeric(b)
+	switch {
+	case n1 && n2:
+		if c := compareInt(len(a), len(b)); c != 0 {
+			return c
+		}
+		return strings.Compare(a, b)
+	case n1:
+		return -1
+	case n2:
+		return 1
+	}
+	return strings.Compare(a, b)
+}
+
+func isNumeric(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+func compareInt(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,81 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+)
+
+func ExampleParseVersion() {
+	for _, s := range []string{"1.4.2", "v1.2.3", "17", "2.0.0-rc.1+build.5", "1.02.3", "1.2.3.4", "1.2.x", "1.0.0-", ""} {
+		v, err := ParseVersion(s)
+		fmt.Printf("test: ParseVersion(\"%v\") -> [%v] [err:%v]\n", s, v, err)
+	}
+
+	//Output:
+	//test: ParseVersion("1.4.2") -> [1.4.2] [err:<nil>]
+	//test: ParseVersion("v1.2.3") -> [1.2.3] [err:<nil>]
+	//test: ParseVersion("17") -> [17.0.0] [err:<nil>]
+	//test: ParseVersion("2.0.0-rc.1+build.5") -> [2.0.0-rc.1+build.5] [err:<nil>]
+	//test: ParseVersion("1.02.3") -> [0.0.0] [err:version component is invalid, leading zero: [1.02.3]]
+	//test: ParseVersion("1.2.3.4") -> [0.0.0] [err:version has too many components: [1.2.3.4]]
+	//test: ParseVersion("1.2.x") -> [0.0.0] [err:version component is invalid, "x": [1.2.x]]
+	//test: ParseVersion("1.0.0-") -> [0.0.0] [err:version pre-release identifier is empty: [1.0.0-]]
+	//test: ParseVersion("") -> [0.0.0] [err:version is empty]
+
+}
+
+func ExampleVersion_Compare() {
+	list := []string{"1.0.0", "1.0.0-rc.1", "1.0.0-alpha.beta", "1.0.0-beta.11", "1.0.0-alpha", "1.0.0-beta", "1.0.0-alpha.1", "1.0.0-beta.2", "0.9.12", "1.10.0", "1.9.0"}
+	var versions []Version
+	for _, s := range list {
+		v, _ := ParseVersion(s)
+		versions = append(versions, v)
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i].Compare(versions[j]) < 0 })
+	fmt.Printf("test: Compare() -> %v\n", versions)
+
+	v, _ := ParseVersion("1.4.2")
+	for _, s := range []string{"1.4.0", "1.5.0", "2.0.0"} {
+		v2, _ := ParseVersion(s)
+		fmt.Printf("test: Compatible(\"%v\",\"%v\") -> %v\n", v, v2, v.Compatible(v2))
+	}
+
+	//Output:
+	//test: Compare() -> [0.9.12 1.0.0-alpha 1.0.0-alpha.1 1.0.0-alpha.beta 1.0.0-beta 1.0.0-beta.2 1.0.0-beta.11 1.0.0-rc.1 1.0.0 1.9.0 1.10.0]
+	//test: Compatible("1.4.2","1.4.0") -> true
+	//test: Compatible("1.4.2","1.5.0") -> false
+	//test: Compatible("1.4.2","2.0.0") -> false
+
+}
+
+func ExampleLatestVersion() {
+	names := []string{
+		"acme:billing:agent/rater#1.4.2",
+		"acme:billing:agent/rater#1.10.0",
+		"acme:billing:agent/rater#2.0.1",
+		"acme:billing:agent/rater#3.0.0-rc.1",
+		"acme:billing:agent/rater#invalid",
+		"acme:billing:agent/rater",
+		"acme:orders:agent/rater#9.0.0",
+	}
+	name, ok := LatestVersion(names, "acme:billing:agent/rater")
+	fmt.Printf("test: LatestVersion() -> [%v] [ok:%v]\n", name, ok)
+
+	v, _ := NameVersion("acme:billing:agent/rater#1.2")
+	name, ok = CompatibleVersion(names, "acme:billing:agent/rater", v)
+	fmt.Printf("test: CompatibleVersion(\"%v\") -> [%v] [ok:%v]\n", v, name, ok)
+
+	v, _ = NewName("acme:billing:agent/rater#3").Version()
+	name, ok = CompatibleVersion(names, "acme:billing:agent/rater", v)
+	fmt.Printf("test: CompatibleVersion(\"%v\") -> [%v] [ok:%v]\n", v, name, ok)
+
+	name, ok = LatestVersion([]string{"acme:billing:agent/rater#3.0.0-beta.2", "acme:billing:agent/rater#3.0.0-rc.1"}, "acme:billing:agent/rater")
+	fmt.Printf("test: LatestVersion() -> [%v] [ok:%v]\n", name, ok)
+
+	//Output:
+	//test: LatestVersion() -> [acme:billing:agent/rater#2.0.1] [ok:true]
+	//test: CompatibleVersion("1.2.0") -> [acme:billing:agent/rater#1.10.0] [ok:true]
+	//test: CompatibleVersion("3.0.0") -> [] [ok:false]
+	//test: LatestVersion() -> [acme:billing:agent/rater#3.0.0-rc.1] [ok:true]
+
+}
@@ -0,0 +1,186 @@
+package fmtx
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+const (
+	KB = int64(1000)
+	MB = KB * 1000
+	GB = MB * 1000
+	TB = GB * 1000
+	PB = TB * 1000
+	EB = PB * 1000
+
+	KiB = int64(1024)
+	MiB = KiB * 1024
+	GiB = MiB * 1024
+	TiB = GiB * 1024
+	PiB = TiB * 1024
+	EiB = PiB * 1024
+)
+
+// ByteSizeStyle - FormatByteSize style
+type ByteSizeStyle int
+
+const (
+	ByteSizeSI  ByteSizeStyle = iota // 1.5MB, powers of 1000
+	ByteSizeIEC                      // 1.5MiB, powers of 1024
+)
+
+var (
+	byteSizeUnits = map[string]int64{
+		"b":     1,
+		"byte":  1,
+		"bytes": 1,
+		"k":     KB,
+		"kb":    KB,
+		"m":     MB,
+		"mb":    MB,
+		"g":     GB,
+		"gb":    GB,
+		"t":     TB,
+		"tb":    TB,
+		"p":     PB,
+		"pb":    PB,
+		"e":     EB,
+		"eb":    EB,
+		"ki":    KiB,
+		"kib":   KiB,
+		"mi":    MiB,
+		"mib":   MiB,
+		"gi":    GiB,
+		"gib":   GiB,
+		"ti":    TiB,
+		"tib":   TiB,
+		"pi":    PiB,
+		"pib":   PiB,
+		"ei":    EiB,
+		"eib":   EiB,
+	}
+	formatSIUnits  = []formatByteUnit{{EB, "EB"}, {PB, "PB"}, {TB, "TB"}, {GB, "GB"}, {MB, "MB"}, {KB, "KB"}}
+	formatIECUnits = []formatByteUnit{{EiB, "EiB"}, {PiB, "PiB"}, {TiB, "TiB"}, {GiB, "GiB"}, {MiB, "MiB"}, {KiB, "KiB"}}
+)
+
+type formatByteUnit struct {
+	unit int64
+	name string
+}
+
+// ParseByteSize - parse a byte size, as in 512k, 10MB or 1.5GiB. Units are case-insensitive, SI units, as in k, KB
+// and MB, are powers of 1000, and IEC units, as in Ki, KiB and MiB, are powers of 1024. A number without a unit
+// is bytes, a fractional number of bytes is truncated, and an empty string is 0.
+func ParseByteSize(s string) (int64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	t := strings.TrimSpace(s)
+	if t != "" && t[0] == '+' {
+		t = t[1:]
+	}
+	var whole, frac uint64
+	scale := uint64(1)
+	i := 0
+	for ; i < len(t) && t[i] >= '0' && t[i] <= '9'; i++ {
+		d := uint64(t[i] - '0')
+		if whole > (math.MaxInt64-d)/10 {
+			return 0, errors.New(fmt.Sprintf("byte size is out of range: [%v]", s))
+		}
+		whole = whole*10 + d
+	}
+	digits := i
+	if i < len(t) && t[i] == '.' {
+		i++
+		for ; i < len(t) && t[i] >= '0' && t[i] <= '9'; i++ {
+			if scale < 1e18 {
+				frac = frac*10 + uint64(t[i]-'0')
+				scale *= 10
+			}
+			digits++
+		}
+	}
+	if digits == 0 {
+		return 0, errors.New(fmt.Sprintf("byte size is invalid: [%v]", s))
+	}
+	unit := int64(1)
+	if suffix := strings.TrimLeft(t[i:], " "); suffix != "" {
+		u, ok := byteSizeUnits[strings.ToLower(suffix)]
+		if !ok {
+			return 0, errors.New(fmt.Sprintf("byte size unit %q is invalid: [%v]", suffix, s))
+		}
+		unit = u
+	}
+	if whole > uint64(math.MaxInt64/unit) {
+		return 0, errors.New(fmt.Sprintf("byte size is out of range: [%v]", s))
+	}
+	n := int64(whole) * unit
+	if frac > 0 {
+		f := int64(float64(frac) * (float64(unit) / float64(scale)))
+		if n > math.MaxInt64-f {
+			return 0, errors.New(fmt.Sprintf("byte size is out of range: [%v]", s))
+		}
+		n += f
+	}
+	return n, nil
+}
+
+// FormatByteSize - format a byte size in the largest unit that is not greater than the size, rounded to 2
+// decimal places, as in 1.5MiB or 512B. A size that rounds up to the next unit is formatted in that unit, as in 1MB
+// and not 1000KB. A non-negative result can be parsed by ParseByteSize, so a size is not rounded up past
+// math.MaxInt64. A negative size has a leading '-', which ParseByteSize does not accept.
+func FormatByteSize(n int64, style ByteSizeStyle) string {
+	sign := ""
+	// Use an unsigned value, as -math.MinInt64 overflows
+	u := uint64(n)
+	if n < 0 {
+		sign = "-"
+		u = uint64(-(n + 1)) + 1
+	}
+	units, base := formatSIUnits, uint64(1000)
+	if style == ByteSizeIEC {
+		units, base = formatIECUnits, 1024
+	}
+	for i := 0; i < len(units); i++ {
+		if u < uint64(units[i].unit) {
+			continue
+		}
+		h, up := byteSizeHundredths(u, uint64(units[i].unit))
+		if h >= base*100 && i > 0 {
+			i--
+			h, up = byteSizeHundredths(u, uint64(units[i].unit))
+		}
+		s := formatHundredths(h) + units[i].name
+		if up && sign == "" {
+			if _, err := ParseByteSize(s); err != nil {
+				s = formatHundredths(h-1) + units[i].name
+			}
+		}
+		return sign + s
+	}
+	return sign + strconv.FormatUint(u, 10) + "B"
+}
+
+// byteSizeHundredths - the size in hundredths of a unit, rounded half up, and if the size was rounded up
+func byteSizeHundredths(u, unit uint64) (uint64, bool) {
+	hi, lo := bits.Mul64(u%unit, 100)
+	f, rem := bits.Div64(hi, lo, unit)
+	h := u/unit*100 + f
+	if rem*2 >= unit {
+		return h + 1, true
+	}
+	return h, false
+}
+
+// formatHundredths - format hundredths without trailing zeros, as in 1.5 or 2
+func formatHundredths(h uint64) string {
+	s := strconv.FormatUint(h/100, 10)
+	if f := h % 100; f != 0 {
+		s += strings.TrimRight("."+strconv.FormatUint(f/10, 10)+strconv.FormatUint(f%10, 10), "0")
+	}
+	return s
+}
@@ -0,0 +1,57 @@
+package fmtx
+
+import (
+	"fmt"
+	"math"
+)
+
+func ExampleParseByteSize() {
+	for _, s := range []string{"", "1024", "512k", "10MB", "10mb", "1.5GiB", "64 KiB", "2mi", "9223372036854775807", "9223372036854775808", "8EiB", "9.3EB", "12XB", "-1KB", "MB"} {
+		n, err := ParseByteSize(s)
+		fmt.Printf("test: ParseByteSize(\"%v\") [err:%v] [size:%v]\n", s, err, n)
+	}
+
+	//Output:
+	//test: ParseByteSize("") [err:<nil>] [size:0]
+	//test: ParseByteSize("1024") [err:<nil>] [size:1024]
+	//test: ParseByteSize("512k") [err:<nil>] [size:512000]
+	//test: ParseByteSize("10MB") [err:<nil>] [size:10000000]
+	//test: ParseByteSize("10mb") [err:<nil>] [size:10000000]
+	//test: ParseByteSize("1.5GiB") [err:<nil>] [size:1610612736]
+	//test: ParseByteSize("64 KiB") [err:<nil>] [size:65536]
+	//test: ParseByteSize("2mi") [err:<nil>] [size:2097152]
+	//test: ParseByteSize("9223372036854775807") [err:<nil>] [size:9223372036854775807]
+	//test: ParseByteSize("9223372036854775808") [err:byte size is out of range: [9223372036854775808]] [size:0]
+	//test: ParseByteSize("8EiB") [err:byte size is out of range: [8EiB]] [size:0]
+	//test: ParseByteSize("9.3EB") [err:byte size is out of range: [9.3EB]] [size:0]
+	//test: ParseByteSize("12XB") [err:byte size unit "XB" is invalid: [12XB]] [size:0]
+	//test: ParseByteSize("-1KB") [err:byte size is invalid: [-1KB]] [size:0]
+	//test: ParseByteSize("MB") [err:byte size is invalid: [MB]] [size:0]
+
+}
+
+func ExampleFormatByteSize() {
+	for _, n := range []int64{0, 512, 1536, 10 * MB, 1610612736, 999999, 1048575, math.MaxInt64, -2 * KiB} {
+		si, iec := FormatByteSize(n, ByteSizeSI), FormatByteSize(n, ByteSizeIEC)
+		fmt.Printf("test: FormatByteSize(%v) [si:%v] [iec:%v]\n", n, si, iec)
+		if n >= 0 {
+			_, err1 := ParseByteSize(si)
+			_, err2 := ParseByteSize(iec)
+			if err1 != nil || err2 != nil {
+				fmt.Printf("test: ParseByteSize() [si:%v] [iec:%v]\n", err1, err2)
+			}
+		}
+	}
+
+	//Output:
+	//test: FormatByteSize(0) [si:0B] [iec:0B]
+	//test: FormatByteSize(512) [si:512B] [iec:512B]
+	//test: FormatByteSize(1536) [si:1.54KB] [iec:1.5KiB]
+	//test: FormatByteSize(10000000) [si:10MB] [iec:9.54MiB]
+	//test: FormatByteSize(1610612736) [si:1.61GB] [iec:1.5GiB]
+	//test: FormatByteSize(999999) [si:1MB] [iec:976.56KiB]
+	//test: FormatByteSize(1048575) [si:1.05MB] [iec:1MiB]
+	//test: FormatByteSize(9223372036854775807) [si:9.22EB] [iec:7.99EiB]
+	//test: FormatByteSize(-2048) [si:-2.05KB] [iec:-2KiB]
+
+}
@@ -1,49 +1,278 @@
 package fmtx
 
 import (
+	"errors"
+	"fmt"
+	"math"
 	"strconv"
 	"strings"
 	"time"
 )
 
+const (
+	Day  = time.Hour * 24
+	Week = Day * 7
+)
+
+// DurationStyle - FormatDuration style
+type DurationStyle int
+
+const (
+	DurationCompact DurationStyle = iota // 1h30m15s
+	DurationHuman                        // 1 hour, 30 minutes, 15 seconds
+)
+
+var (
+	durationUnits = map[string]time.Duration{
+		"ns":   time.Nanosecond,
+		"us":   time.Microsecond,
+		"µs":   time.Microsecond, // U+00B5 micro sign
+		"μs":   time.Microsecond, // U+03BC Greek letter mu
+		"ms":   time.Millisecond,
+		"s":    time.Second,
+		"sec":  time.Second,
+		"secs": time.Second,
+		"m":    time.Minute,
+		"min":  time.Minute,
+		"mins": time.Minute,
+		"h":    time.Hour,
+		"hr":   time.Hour,
+		"hrs":  time.Hour,
+		"d":    Day,
+		"w":    Week,
+	}
+	isoUnits = map[byte]time.Duration{
+		'W': Week,
+		'D': Day,
+		'H': time.Hour,
+		'M': time.Minute,
+		'S': time.Second,
+	}
+	formatUnits = []struct {
+		unit    time.Duration
+		compact string
+		human   string
+	}{
+		{Day, "d", "day"},
+		{time.Hour, "h", "hour"},
+		{time.Minute, "m", "minute"},
+		{time.Second, "s", "second"},
+		{time.Millisecond, "ms", "millisecond"},
+		{time.Microsecond, "µs", "microsecond"},
+		{time.Nanosecond, "ns", "nanosecond"},
+	}
+)
+
+// ParseDuration - parse a duration of one or more components, as in 2m35sec or 6hr23m, with an optional sign.
+// Units are ns, µs or us, ms, s or sec, m or min, h or hr, d and w, and values can be fractional, as in 1.5h.
+// Spaces are allowed between components and between a value and its unit, as in 1h 30m or 10 mins. Only the
+// last value can omit the unit, and is then seconds, as in 1m30. An ISO 8601 duration, as in PT1H30M or P1DT12H,
+// is also supported. An empty string is 0.
 func ParseDuration(s string) (time.Duration, error) {
 	if s == "" {
 		return 0, nil
 	}
-	tokens := strings.Split(s, "ms")
-	if len(tokens) == 2 {
-		val, err := strconv.Atoi(tokens[0])
+	t := strings.TrimSpace(s)
+	neg := false
+	if t != "" && (t[0] == '-' || t[0] == '+') {
+		neg = t[0] == '-'
+		t = t[1:]
+	}
+	if t == "" {
+		return 0, errors.New(fmt.Sprintf("duration is invalid: [%v]", s))
+	}
+	// A negative duration can be one more than math.MaxInt64, so math.MinInt64 round trips with FormatDuration
+	limit := uint64(math.MaxInt64)
+	if neg {
+		limit++
+	}
+	var u uint64
+	var err error
+	if t[0] == 'P' || t[0] == 'p' {
+		u, err = parseIsoDuration(s, t[1:], limit)
+	} else {
+		u, err = parseCompoundDuration(s, t, limit)
+	}
+	if err != nil {
+		return 0, err
+	}
+	if neg {
+		// Wraps to math.MinInt64 for the limit
+		return -time.Duration(u), nil
+	}
+	return time.Duration(u), nil
+}
+
+func parseCompoundDuration(s, t string, limit uint64) (uint64, error) {
+	var d uint64
+	for t != "" {
+		t = strings.TrimLeft(t, " ")
+		v, rest, err := parseDecimal(s, t)
 		if err != nil {
 			return 0, err
 		}
-		return time.Duration(val) * time.Millisecond, nil
-	}
-	tokens = strings.Split(s, "µs")
-	if len(tokens) == 2 {
-		val, err := strconv.Atoi(tokens[0])
-		if err != nil {
+		rest = strings.TrimLeft(rest, " ")
+		i := 0
+		for i < len(rest) && !isDurationDigit(rest[i]) && rest[i] != ' ' {
+			i++
+		}
+		unit := time.Second
+		if i > 0 {
+			u, ok := durationUnits[strings.ToLower(rest[:i])]
+			if !ok {
+				return 0, errors.New(fmt.Sprintf("duration unit %q is invalid: [%v]", rest[:i], s))
+			}
+			unit = u
+		} else if rest != "" {
+			// Only the last component may omit the unit
+			return 0, errors.New(fmt.Sprintf("duration unit is missing: [%v]", s))
+		}
+		if d, err = addDuration(s, d, v, unit, limit); err != nil {
 			return 0, err
 		}
-		return time.Duration(val) * time.Microsecond, nil
+		t = rest[i:]
 	}
-	tokens = strings.Split(s, "m")
-	if len(tokens) == 2 {
-		val, err := strconv.Atoi(tokens[0])
+	return d, nil
+}
+
+// parseIsoDuration - ISO 8601 PnWnDTnHnMnS, years and months are not supported as they are not a fixed length
+func parseIsoDuration(s, t string, limit uint64) (uint64, error) {
+	if t == "" {
+		return 0, errors.New(fmt.Sprintf("duration is invalid: [%v]", s))
+	}
+	var d uint64
+	inTime := false
+	for t != "" {
+		if t[0] == 'T' || t[0] == 't' {
+			if inTime || len(t) == 1 {
+				return 0, errors.New(fmt.Sprintf("duration is invalid: [%v]", s))
+			}
+			inTime = true
+			t = t[1:]
+			continue
+		}
+		v, rest, err := parseDecimal(s, strings.Replace(t, ",", ".", 1))
 		if err != nil {
 			return 0, err
 		}
-		return time.Duration(val) * time.Minute, nil
+		if rest == "" {
+			return 0, errors.New(fmt.Sprintf("duration unit is missing: [%v]", s))
+		}
+		c := rest[0]
+		if c >= 'a' && c <= 'z' {
+			c -= 'a' - 'A'
+		}
+		unit, ok := isoUnits[c]
+		if !ok || inTime != (c == 'H' || c == 'M' || c == 'S') {
+			return 0, errors.New(fmt.Sprintf("duration unit %q is invalid: [%v]", rest[:1], s))
+		}
+		if d, err = addDuration(s, d, v, unit, limit); err != nil {
+			return 0, err
+		}
+		t = rest[1:]
 	}
-	// Assume seconds
-	tokens = strings.Split(s, "s")
-	if len(tokens) == 2 {
-		s = tokens[0]
+	return d, nil
+}
+
+type decimal struct {
+	whole uint64
+	frac  uint64
+	scale uint64
+}
+
+// parseDecimal - parse a non-negative decimal, returning the remaining string. The whole part can be one more
+// than math.MaxInt64, the range of a negative duration.
+func parseDecimal(s, t string) (decimal, string, error) {
+	var v decimal
+	v.scale = 1
+	i := 0
+	for ; i < len(t) && t[i] >= '0' && t[i] <= '9'; i++ {
+		digit := uint64(t[i] - '0')
+		if v.whole > (math.MaxInt64+1-digit)/10 {
+			return v, "", errors.New(fmt.Sprintf("duration is out of range: [%v]", s))
+		}
+		v.whole = v.whole*10 + digit
 	}
-	val, err := strconv.Atoi(s)
-	if err != nil {
-		return 0, err
+	digits := i
+	if i < len(t) && t[i] == '.' {
+		i++
+		for ; i < len(t) && t[i] >= '0' && t[i] <= '9'; i++ {
+			// Additional digits are beyond nanosecond precision
+			if v.scale < 1e18 {
+				v.frac = v.frac*10 + uint64(t[i]-'0')
+				v.scale *= 10
+			}
+			digits++
+		}
+	}
+	if digits == 0 {
+		return v, "", errors.New(fmt.Sprintf("duration is invalid: [%v]", s))
+	}
+	return v, t[i:], nil
+}
+
+// addDuration - add a component to the duration magnitude, which cannot be more than the limit
+func addDuration(s string, d uint64, v decimal, unit time.Duration, limit uint64) (uint64, error) {
+	if v.whole > limit/uint64(unit) {
+		return 0, errors.New(fmt.Sprintf("duration is out of range: [%v]", s))
+	}
+	n := v.whole * uint64(unit)
+	if v.frac > 0 {
+		f := uint64(float64(v.frac) * (float64(unit) / float64(v.scale)))
+		if f > limit-n {
+			return 0, errors.New(fmt.Sprintf("duration is out of range: [%v]", s))
+		}
+		n += f
+	}
+	if d > limit-n {
+		return 0, errors.New(fmt.Sprintf("duration is out of range: [%v]", s))
+	}
+	return d + n, nil
+}
+
+func isDurationDigit(c byte) bool {
+	return c >= '0' && c <= '9' || c == '.'
+}
+
+// FormatDuration - format a duration, the compact style can be parsed by ParseDuration
+func FormatDuration(d time.Duration, style DurationStyle) string {
+	if d == 0 {
+		if style == DurationHuman {
+			return "0 seconds"
+		}
+		return "0s"
+	}
+	var sb strings.Builder
+	// Use an unsigned value, as -math.MinInt64 overflows
+	u := uint64(d)
+	if d < 0 {
+		sb.WriteString("-")
+		u = uint64(-(d + 1)) + 1
+	}
+	first := true
+	for _, f := range formatUnits {
+		n := u / uint64(f.unit)
+		if n == 0 {
+			continue
+		}
+		u -= n * uint64(f.unit)
+		if style == DurationHuman {
+			if !first {
+				sb.WriteString(", ")
+			}
+			sb.WriteString(strconv.FormatUint(n, 10))
+			sb.WriteString(" ")
+			sb.WriteString(f.human)
+			if n != 1 {
+				sb.WriteString("s")
+			}
+		} else {
+			sb.WriteString(strconv.FormatUint(n, 10))
+			sb.WriteString(f.compact)
+		}
+		first = false
 	}
-	return time.Duration(val) * time.Second, nil
+	return sb.String()
 }
 
 // Milliseconds - convert time.Duration to milliseconds
@@ -0,0 +1,38 @@
+package fmtx
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func FuzzParseDuration(f *testing.F) {
+	for _, s := range []string{"2m35sec", "1h 30m", "10 mins", "1.5", "-1m30s", "PT1H30M", "9223372036854775807ns", FormatDuration(math.MinInt64, DurationCompact), "1 30", ""} {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		d, err := ParseDuration(s)
+		if err != nil {
+			return
+		}
+		// The compact style must round trip
+		c := FormatDuration(d, DurationCompact)
+		d2, err := ParseDuration(c)
+		if err != nil || d2 != d {
+			t.Errorf("ParseDuration(%q) round trip %q = %v, %v, want %v", s, c, d2, err, d)
+		}
+	})
+}
+
+func FuzzFormatDuration(f *testing.F) {
+	for _, n := range []int64{0, 1, -1, int64(time.Hour), math.MaxInt64, math.MinInt64} {
+		f.Add(n)
+	}
+	f.Fuzz(func(t *testing.T, n int64) {
+		c := FormatDuration(time.Duration(n), DurationCompact)
+		d, err := ParseDuration(c)
+		if err != nil || d != time.Duration(n) {
+			t.Errorf("FormatDuration(%v) = %q, ParseDuration() = %v, %v", time.Duration(n), c, d, err)
+		}
+	})
+}
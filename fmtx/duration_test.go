@@ -1,6 +1,10 @@
 package fmtx
 
-import "fmt"
+import (
+	"fmt"
+	"math"
+	"time"
+)
 
 func ExampleParseDuration() {
 	s := ""
@@ -44,8 +48,8 @@ func ExampleParseDuration() {
 
 	//Output:
 	//test: ParseDuration("") [err:<nil>] [duration:0s]
-	//test: ParseDuration("  ") [err:strconv.Atoi: parsing "  ": invalid syntax] [duration:0s]
-	//test: ParseDuration("12as") [err:strconv.Atoi: parsing "12a": invalid syntax] [duration:0s]
+	//test: ParseDuration("  ") [err:duration is invalid: [  ]] [duration:0s]
+	//test: ParseDuration("12as") [err:duration unit "as" is invalid: [12as]] [duration:0s]
 	//test: ParseDuration("1000") [err:<nil>] [duration:16m40s]
 	//test: ParseDuration("1000s") [err:<nil>] [duration:16m40s]
 	//test: ParseDuration("1000m") [err:<nil>] [duration:16h40m0s]
@@ -54,3 +58,69 @@ func ExampleParseDuration() {
 	//test: ParseDuration("10µs") [err:<nil>] [duration:10µs]
 
 }
+
+func ExampleParseDuration_compound() {
+	for _, s := range []string{"2m35sec", "6hr23m", "1h", "1m30s", "1.5h", "-1m30s", "+250ms", "1d12h", "2w", "1h 30m", "10us", "10μs", "100ns", "1.5"} {
+		duration, err := ParseDuration(s)
+		fmt.Printf("test: ParseDuration(\"%v\") [err:%v] [duration:%v]\n", s, err, duration)
+	}
+
+	//Output:
+	//test: ParseDuration("2m35sec") [err:<nil>] [duration:2m35s]
+	//test: ParseDuration("6hr23m") [err:<nil>] [duration:6h23m0s]
+	//test: ParseDuration("1h") [err:<nil>] [duration:1h0m0s]
+	//test: ParseDuration("1m30s") [err:<nil>] [duration:1m30s]
+	//test: ParseDuration("1.5h") [err:<nil>] [duration:1h30m0s]
+	//test: ParseDuration("-1m30s") [err:<nil>] [duration:-1m30s]
+	//test: ParseDuration("+250ms") [err:<nil>] [duration:250ms]
+	//test: ParseDuration("1d12h") [err:<nil>] [duration:36h0m0s]
+	//test: ParseDuration("2w") [err:<nil>] [duration:336h0m0s]
+	//test: ParseDuration("1h 30m") [err:<nil>] [duration:1h30m0s]
+	//test: ParseDuration("10us") [err:<nil>] [duration:10µs]
+	//test: ParseDuration("10μs") [err:<nil>] [duration:10µs]
+	//test: ParseDuration("100ns") [err:<nil>] [duration:100ns]
+	//test: ParseDuration("1.5") [err:<nil>] [duration:1.5s]
+
+}
+
+func ExampleParseDuration_iso8601() {
+	for _, s := range []string{"PT1H30M", "P1DT12H", "PT0.5S", "P1W", "-PT15M", "PT", "P1M", "PT1D", "9999999h", "1h30", "1 h", "10 mins", "1m 30", "1 30", "9223372036854775807ns", "9223372036854775808ns", "-9223372036854775808ns"} {
+		duration, err := ParseDuration(s)
+		fmt.Printf("test: ParseDuration(\"%v\") [err:%v] [duration:%v]\n", s, err, duration)
+	}
+
+	//Output:
+	//test: ParseDuration("PT1H30M") [err:<nil>] [duration:1h30m0s]
+	//test: ParseDuration("P1DT12H") [err:<nil>] [duration:36h0m0s]
+	//test: ParseDuration("PT0.5S") [err:<nil>] [duration:500ms]
+	//test: ParseDuration("P1W") [err:<nil>] [duration:168h0m0s]
+	//test: ParseDuration("-PT15M") [err:<nil>] [duration:-15m0s]
+	//test: ParseDuration("PT") [err:duration is invalid: [PT]] [duration:0s]
+	//test: ParseDuration("P1M") [err:duration unit "M" is invalid: [P1M]] [duration:0s]
+	//test: ParseDuration("PT1D") [err:duration unit "D" is invalid: [PT1D]] [duration:0s]
+	//test: ParseDuration("9999999h") [err:duration is out of range: [9999999h]] [duration:0s]
+	//test: ParseDuration("1h30") [err:<nil>] [duration:1h0m30s]
+	//test: ParseDuration("1 h") [err:<nil>] [duration:1h0m0s]
+	//test: ParseDuration("10 mins") [err:<nil>] [duration:10m0s]
+	//test: ParseDuration("1m 30") [err:<nil>] [duration:1m30s]
+	//test: ParseDuration("1 30") [err:duration unit is missing: [1 30]] [duration:0s]
+	//test: ParseDuration("9223372036854775807ns") [err:<nil>] [duration:2562047h47m16.854775807s]
+	//test: ParseDuration("9223372036854775808ns") [err:duration is out of range: [9223372036854775808ns]] [duration:0s]
+	//test: ParseDuration("-9223372036854775808ns") [err:<nil>] [duration:-2562047h47m16.854775808s]
+
+}
+
+func ExampleFormatDuration() {
+	for _, d := range []time.Duration{0, time.Hour + time.Minute*30, Day + time.Second + time.Millisecond*250, -time.Minute, time.Nanosecond * 1500, math.MinInt64} {
+		fmt.Printf("test: FormatDuration(%v) [compact:%v] [human:%v]\n", d, FormatDuration(d, DurationCompact), FormatDuration(d, DurationHuman))
+	}
+
+	//Output:
+	//test: FormatDuration(0s) [compact:0s] [human:0 seconds]
+	//test: FormatDuration(1h30m0s) [compact:1h30m] [human:1 hour, 30 minutes]
+	//test: FormatDuration(24h0m1.25s) [compact:1d1s250ms] [human:1 day, 1 second, 250 milliseconds]
+	//test: FormatDuration(-1m0s) [compact:-1m] [human:-1 minute]
+	//test: FormatDuration(1.5µs) [compact:1µs500ns] [human:1 microsecond, 500 nanoseconds]
+	//test: FormatDuration(-2562047h47m16.854775808s) [compact:-106751d23h47m16s854ms775µs808ns] [human:-106751 days, 23 hours, 47 minutes, 16 seconds, 854 milliseconds, 775 microseconds, 808 nanoseconds]
+
+}
@@ -1,29 +1,25 @@
 package fmtx
 
-import "fmt"
-
-const (
-	markupNull   = "\"%v\":null"
-	markupString = "\"%v\":\"%v\""
-	markupValue  = "\"%v\":%v"
-)
-
 // JsonString - Json format a string value
 func JsonString(value string) string {
 	if len(value) == 0 {
 		return "null"
 	}
-	return "\"" + value + "\""
+	return string(AppendJsonString(nil, value))
 }
 
-// JsonMarkup - markup a name/value pair
+// JsonMarkup - markup a name/value pair, a non-string value is written as is and must be valid JSON
 func JsonMarkup(name, value string, stringValue bool) string {
-	if len(value) == 0 {
-		return fmt.Sprintf(markupNull, name)
-	}
-	format := markupString
-	if !stringValue {
-		format = markupValue
+	buf := make([]byte, 0, len(name)+len(value)+8)
+	buf = AppendJsonString(buf, name)
+	buf = append(buf, ':')
+	switch {
+	case len(value) == 0:
+		buf = append(buf, "null"...)
+	case stringValue:
+		buf = AppendJsonString(buf, value)
+	default:
+		buf = append(buf, value...)
 	}
-	return fmt.Sprintf(format, name, value)
+	return string(buf)
 }
@@ -0,0 +1,347 @@
+package fmtx
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	jsonObject = byte('{')
+	jsonArray  = byte('[')
+
+	jsonFlushSize = 4096
+	hexDigits     = "0123456789abcdef"
+)
+
+// JsonWriter - streaming JSON writer, appending to a []byte or writing to an io.Writer. Strings are escaped as
+// per RFC 8259, https://datatracker.ietf.org/doc/html/rfc8259, and no reflection is used. The first error,
+// as in an unbalanced end or a value without a name in an object, is returned by Err, and stops all writes.
+type JsonWriter struct {
+	buf   []byte
+	w     io.Writer
+	stack []byte
+	comma bool
+	named bool
+	err   error
+}
+
+// NewJsonWriter - create a writer that appends to buf
+func NewJsonWriter(buf []byte) *JsonWriter {
+	j := new(JsonWriter)
+	j.buf = buf
+	return j
+}
+
+// NewJsonStreamWriter - create a writer that writes to w, Flush must be called when done
+func NewJsonStreamWriter(w io.Writer) *JsonWriter {
+	j := new(JsonWriter)
+	j.w = w
+	j.buf = make([]byte, 0, jsonFlushSize)
+	return j
+}
+
+// Bytes - the appended JSON
+func (j *JsonWriter) Bytes() []byte {
+	return j.buf
+}
+
+// String - the appended JSON
+func (j *JsonWriter) String() string {
+	return string(j.buf)
+}
+
+// Err - the first error
+func (j *JsonWriter) Err() error {
+	return j.err
+}
+
+// Reset - reset the writer, keeping the buffer capacity
+func (j *JsonWriter) Reset() {
+	j.buf = j.buf[:0]
+	j.stack = j.stack[:0]
+	j.comma = false
+	j.named = false
+	j.err = nil
+}
+
+// Flush - write the buffer to the io.Writer
+func (j *JsonWriter) Flush() error {
+	if j.w == nil || len(j.buf) == 0 || j.err != nil {
+		return j.err
+	}
+	_, err := j.w.Write(j.buf)
+	j.buf = j.buf[:0]
+	if err != nil {
+		j.err = err
+	}
+	return j.err
+}
+
+// BeginObject - begin an object
+func (j *JsonWriter) BeginObject() *JsonWriter {
+	return j.begin(jsonObject)
+}
+
+// EndObject - end an object
+func (j *JsonWriter) EndObject() *JsonWriter {
+	return j.end(jsonObject, '}')
+}
+
+// BeginArray - begin an array
+func (j *JsonWriter) BeginArray() *JsonWriter {
+	return j.begin(jsonArray)
+}
+
+// EndArray - end an array
+func (j *JsonWriter) EndArray() *JsonWriter {
+	return j.end(jsonArray, ']')
+}
+
+// Name - the name of the next object member
+func (j *JsonWriter) Name(name string) *JsonWriter {
+	if j.err != nil {
+		return j
+	}
+	if len(j.stack) == 0 || j.stack[len(j.stack)-1] != jsonObject || j.named {
+		j.err = errors.New(fmt.Sprintf("json name is not valid here: %q", name))
+		return j
+	}
+	if j.comma {
+		j.buf = append(j.buf, ',')
+	}
+	j.buf = AppendJsonString(j.buf, name)
+	j.buf = append(j.buf, ':')
+	j.named = true
+	return j
+}
+
+// WriteString - a string value
+func (j *JsonWriter) WriteString(v string) *JsonWriter {
+	if j.value() {
+		j.buf = AppendJsonString(j.buf, v)
+		j.flush()
+	}
+	return j
+}
+
+// WriteInt - an integer value
+func (j *JsonWriter) WriteInt(v int64) *JsonWriter {
+	if j.value() {
+		j.buf = strconv.AppendInt(j.buf, v, 10)
+		j.flush()
+	}
+	return j
+}
+
+// WriteUint - an unsigned integer value
+func (j *JsonWriter) WriteUint(v uint64) *JsonWriter {
+	if j.value() {
+		j.buf = strconv.AppendUint(j.buf, v, 10)
+		j.flush()
+	}
+	return j
+}
+
+// WriteFloat - a floating point value, in the same format as encoding/json. NaN and infinity are not valid
+// JSON, and are recorded as an error.
+func (j *JsonWriter) WriteFloat(v float64) *JsonWriter {
+	if j.err == nil && (math.IsNaN(v) || math.IsInf(v, 0)) {
+		j.err = errors.New(fmt.Sprintf("json float value is not valid: %v", v))
+	}
+	if !j.value() {
+		return j
+	}
+	abs := math.Abs(v)
+	format := byte('f')
+	if abs != 0 && (abs < 1e-6 || abs >= 1e21) {
+		format = 'e'
+	}
+	j.buf = strconv.AppendFloat(j.buf, v, format, -1, 64)
+	if format == 'e' {
+		// Remove the leading zero of a negative exponent, as in 1e-7 and not 1e-07
+		n := len(j.buf)
+		if n >= 4 && j.buf[n-4] == 'e' && j.buf[n-3] == '-' && j.buf[n-2] == '0' {
+			j.buf[n-2] = j.buf[n-1]
+			j.buf = j.buf[:n-1]
+		}
+	}
+	j.flush()
+	return j
+}
+
+// WriteBool - a boolean value
+func (j *JsonWriter) WriteBool(v bool) *JsonWriter {
+	if j.value() {
+		j.buf = strconv.AppendBool(j.buf, v)
+		j.flush()
+	}
+	return j
+}
+
+// WriteNull - a null value
+func (j *JsonWriter) WriteNull() *JsonWriter {
+	if j.value() {
+		j.buf = append(j.buf, "null"...)
+		j.flush()
+	}
+	return j
+}
+
+// WriteTime - a time value formatted with FmtRFC3339Millis
+func (j *JsonWriter) WriteTime(t time.Time) *JsonWriter {
+	return j.WriteString(FmtRFC3339Millis(t))
+}
+
+// WriteRaw - a value that is already valid JSON
+func (j *JsonWriter) WriteRaw(v []byte) *JsonWriter {
+	if j.value() {
+		j.buf = append(j.buf, v...)
+		j.flush()
+	}
+	return j
+}
+
+// StringField - a named string value
+func (j *JsonWriter) StringField(name, v string) *JsonWriter {
+	return j.Name(name).WriteString(v)
+}
+
+// IntField - a named integer value
+func (j *JsonWriter) IntField(name string, v int64) *JsonWriter {
+	return j.Name(name).WriteInt(v)
+}
+
+// FloatField - a named floating point value
+func (j *JsonWriter) FloatField(name string, v float64) *JsonWriter {
+	return j.Name(name).WriteFloat(v)
+}
+
+// BoolField - a named boolean value
+func (j *JsonWriter) BoolField(name string, v bool) *JsonWriter {
+	return j.Name(name).WriteBool(v)
+}
+
+// NullField - a named null value
+func (j *JsonWriter) NullField(name string) *JsonWriter {
+	return j.Name(name).WriteNull()
+}
+
+// TimeField - a named time value
+func (j *JsonWriter) TimeField(name string, t time.Time) *JsonWriter {
+	return j.Name(name).WriteTime(t)
+}
+
+func (j *JsonWriter) begin(kind byte) *JsonWriter {
+	if j.value() {
+		j.buf = append(j.buf, kind)
+		j.stack = append(j.stack, kind)
+		j.comma = false
+	}
+	return j
+}
+
+func (j *JsonWriter) end(kind, c byte) *JsonWriter {
+	if j.err != nil {
+		return j
+	}
+	if len(j.stack) == 0 || j.stack[len(j.stack)-1] != kind || j.named {
+		j.err = errors.New(fmt.Sprintf("json end %q is not balanced", c))
+		return j
+	}
+	j.stack = j.stack[:len(j.stack)-1]
+	j.buf = append(j.buf, c)
+	j.comma = true
+	j.flush()
+	return j
+}
+
+// value - check that a value is valid, and write a separator if needed
+func (j *JsonWriter) value() bool {
+	if j.err != nil {
+		return false
+	}
+	if len(j.stack) > 0 && j.stack[len(j.stack)-1] == jsonObject {
+		if !j.named {
+			j.err = errors.New("json object value does not have a name")
+			return false
+		}
+		j.named = false
+		j.comma = true
+		return true
+	}
+	if j.comma {
+		// A top level value is separated by a newline, as in newline delimited JSON
+		if len(j.stack) == 0 {
+			j.buf = append(j.buf, '\n')
+		} else {
+			j.buf = append(j.buf, ',')
+		}
+	}
+	j.comma = true
+	return true
+}
+
+func (j *JsonWriter) flush() {
+	if j.w != nil && len(j.buf) >= jsonFlushSize {
+		j.Flush()
+	}
+}
+
+// AppendJsonString - append a quoted and escaped JSON string. Invalid UTF-8 is replaced with U+FFFD, and
+// U+2028 and U+2029 are escaped so the JSON is also valid JavaScript.
+func AppendJsonString(buf []byte, s string) []byte {
+	buf = append(buf, '"')
+	start := 0
+	for i := 0; i < len(s); {
+		c := s[i]
+		if c < utf8.RuneSelf {
+			if c >= 0x20 && c != '"' && c != '\\' {
+				i++
+				continue
+			}
+			buf = append(buf, s[start:i]...)
+			switch c {
+			case '"', '\\':
+				buf = append(buf, '\\', c)
+			case '\n':
+				buf = append(buf, '\\', 'n')
+			case '\r':
+				buf = append(buf, '\\', 'r')
+			case '\t':
+				buf = append(buf, '\\', 't')
+			case '\b':
+				buf = append(buf, '\\', 'b')
+			case '\f':
+				buf = append(buf, '\\', 'f')
+			default:
+				buf = append(buf, '\\', 'u', '0', '0', hexDigits[c>>4], hexDigits[c&0xf])
+			}
+			i++
+			start = i
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			buf = append(buf, s[start:i]...)
+			buf = append(buf, "\ufffd"...)
+			i += size
+			start = i
+			continue
+		}
+		if r == '\u2028' || r == '\u2029' {
+			buf = append(buf, s[start:i]...)
+			buf = append(buf, '\\', 'u', '2', '0', '2', hexDigits[r&0xf])
+			i += size
+			start = i
+			continue
+		}
+		i += size
+	}
+	buf = append(buf, s[start:]...)
+	return append(buf, '"')
+}
@@ -0,0 +1,76 @@
+package fmtx
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+func ExampleNewJsonWriter() {
+	ts := time.Date(2024, 6, 3, 18, 4, 5, 123000000, time.UTC)
+	j := NewJsonWriter(nil)
+	j.BeginObject().
+		StringField("method", "GET").
+		IntField("status", 200).
+		FloatField("ratio", 0.25).
+		FloatField("small", 1e-7).
+		FloatField("large", -1e21).
+		BoolField("cached", false).
+		NullField("upstream").
+		TimeField("start", ts).
+		Name("tags").BeginArray().WriteString("a").WriteInt(-1).WriteUint(2).EndArray().
+		Name("route").BeginObject().StringField("name", "search").EndObject().
+		EndObject()
+	fmt.Printf("test: NewJsonWriter() [err:%v] %v\n", j.Err(), j.String())
+
+	//Output:
+	//test: NewJsonWriter() [err:<nil>] {"method":"GET","status":200,"ratio":0.25,"small":1e-7,"large":-1e+21,"cached":false,"upstream":null,"start":"2024-06-03T18:04:05.123Z","tags":["a",-1,2],"route":{"name":"search"}}
+
+}
+
+func ExampleAppendJsonString() {
+	s := "quote\" backslash\\ newline\n tab\t control\x01 invalid\xff separator\u2028"
+	fmt.Printf("test: AppendJsonString() %v\n", string(AppendJsonString(nil, s)))
+
+	fmt.Printf("test: JsonString() %v\n", JsonString("a \"b\""))
+	fmt.Printf("test: JsonMarkup() %v\n", JsonMarkup("agent", "curl\n", true))
+	fmt.Printf("test: JsonMarkup() %v\n", JsonMarkup("bytes", "", false))
+
+	//Output:
+	//test: AppendJsonString() "quote\" backslash\\ newline\n tab\t control\u0001 invalid� separator\u2028"
+	//test: JsonString() "a \"b\""
+	//test: JsonMarkup() "agent":"curl\n"
+	//test: JsonMarkup() "bytes":null
+
+}
+
+func ExampleNewJsonStreamWriter() {
+	var sb strings.Builder
+	j := NewJsonStreamWriter(&sb)
+	j.BeginObject().IntField("id", 1).EndObject()
+	j.BeginObject().IntField("id", 2).EndObject()
+	err := j.Flush()
+	fmt.Printf("test: NewJsonStreamWriter() [err:%v]\n%v\n", err, sb.String())
+
+	j = NewJsonWriter(nil)
+	j.BeginObject().WriteInt(1)
+	fmt.Printf("test: WriteInt() [err:%v]\n", j.Err())
+
+	j = NewJsonWriter(nil)
+	j.BeginArray().EndObject()
+	fmt.Printf("test: EndObject() [err:%v]\n", j.Err())
+
+	j = NewJsonWriter(nil)
+	j.BeginArray().WriteFloat(1).WriteFloat(math.Inf(1)).WriteFloat(2)
+	fmt.Printf("test: WriteFloat() [err:%v] %v\n", j.Err(), j.String())
+
+	//Output:
+	//test: NewJsonStreamWriter() [err:<nil>]
+	//{"id":1}
+	//{"id":2}
+	//test: WriteInt() [err:json object value does not have a name]
+	//test: EndObject() [err:json end '}' is not balanced]
+	//test: WriteFloat() [err:json float value is not valid: +Inf] [1
+
+}
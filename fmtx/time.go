@@ -1,7 +1,10 @@
 package fmtx
 
 import (
+	"errors"
+	"fmt"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -22,58 +25,222 @@ func FmtRFC3339Millis(t time.Time) string {
 }
 
 // ParseRFC3339Millis - parse a string into a time.Time, using the following string : 2023-04-14T14:14:45.522Z
+// Any RFC 3339 timestamp is accepted, see ParseRFC3339.
 func ParseRFC3339Millis(ts string) (time.Time, error) {
-	if len(ts) == 0 {
-		return time.Now().UTC(), nil
+	return ParseRFC3339(ts)
+}
+
+// ParseTimestamp2 - parse a string into a time.Time, using the following string : 2023-04-14 14:14:45.522460
+// Any SQL timestamp is accepted, see ParseSQLTimestamp.
+func ParseTimestamp2(s string) (time.Time, error) {
+	return ParseSQLTimestamp(s)
+}
+
+// ParseTimestamp - parse an RFC 3339 timestamp, an SQL timestamp, or epoch seconds or milliseconds
+func ParseTimestamp(s string) (time.Time, error) {
+	if isEpoch(s) {
+		return ParseEpoch(s)
 	}
-	year, month, day, err := parseYMD(ts)
-	if err != nil {
-		return time.Now().UTC(), err
+	if len(s) > 10 && s[10] == ' ' {
+		return ParseSQLTimestamp(s)
+	}
+	return ParseRFC3339(s)
+}
+
+// ParseRFC3339 - parse an RFC 3339 timestamp, as in 2023-04-14T14:14:45.522Z or 2023-04-14T09:14:45-05:00,
+// with any fractional second precision. The time is returned in UTC, and no allocations are made.
+// https://datatracker.ietf.org/doc/html/rfc3339#section-5.6
+func ParseRFC3339(s string) (time.Time, error) {
+	return parseTimestamp(s, true)
+}
+
+// ParseSQLTimestamp - parse an SQL timestamp, as in 2023-04-14 14:14:45 or 2023-04-14 14:14:45.522460, with an
+// optional Z, ±hh, or ±hh:mm offset. A timestamp without an offset is UTC, and the time is returned in UTC.
+func ParseSQLTimestamp(s string) (time.Time, error) {
+	return parseTimestamp(s, false)
+}
+
+// ParseEpoch - parse epoch seconds, as in 1712930085 or 1712930085.522, or epoch milliseconds, as in
+// 1712930085522. An integer with an absolute value of at least 1e11 is milliseconds, as 1e11 seconds is
+// after the year 5000.
+func ParseEpoch(s string) (time.Time, error) {
+	if !isEpoch(s) {
+		return time.Time{}, timestampError("epoch is invalid", s)
+	}
+	t := s
+	neg := t[0] == '-'
+	if neg {
+		t = t[1:]
+	}
+	whole, frac, _ := strings.Cut(t, ".")
+	if len(whole) > 18 {
+		return time.Time{}, timestampError("epoch is out of range", s)
+	}
+	u, _ := parseDigits(whole)
+	n := int64(u)
+	var ns int64
+	if frac != "" {
+		f, digits := parseFraction(frac)
+		ns = f
+		if digits != len(frac) {
+			return time.Time{}, timestampError("epoch is invalid", s)
+		}
 	}
-	hour, min, sec, ms, err2 := parseHMSM(ts)
-	if err2 != nil {
-		return time.Now().UTC(), err2
+	if neg {
+		n, ns = -n, -ns
 	}
-	return time.Date(year, time.Month(month), day, hour, min, sec, ms*1e6, time.UTC), nil
+	if frac == "" && (n >= 1e11 || n <= -1e11) {
+		return time.UnixMilli(n).UTC(), nil
+	}
+	return time.Unix(n, ns).UTC(), nil
 }
 
-// ParseTimestamp2 - parse a string into a time.Time, using the following string : 2023-04-14 14:14:45.522460
-func ParseTimestamp2(s string) (time.Time, error) {
-	if len(s) == 0 {
-		return time.Now().UTC(), nil
+func parseTimestamp(s string, rfc bool) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, timestampError("timestamp is empty", s)
 	}
-	year, err := strconv.Atoi(s[0:4])
-	if err != nil {
-		return time.Now().UTC(), err
+	if len(s) < 19 || s[4] != '-' || s[7] != '-' || s[13] != ':' || s[16] != ':' {
+		return time.Time{}, timestampError("timestamp is invalid", s)
+	}
+	if rfc && s[10] != 'T' && s[10] != 't' || !rfc && s[10] != ' ' {
+		return time.Time{}, timestampError("timestamp date and time separator is invalid", s)
 	}
-	month, er1 := strconv.Atoi(s[5:7])
-	if er1 != nil {
-		return time.Now().UTC(), er1
+	year, ok1 := parseDigits(s[0:4])
+	month, ok2 := parseDigits(s[5:7])
+	day, ok3 := parseDigits(s[8:10])
+	hour, ok4 := parseDigits(s[11:13])
+	minute, ok5 := parseDigits(s[14:16])
+	sec, ok6 := parseDigits(s[17:19])
+	if !(ok1 && ok2 && ok3 && ok4 && ok5 && ok6) {
+		return time.Time{}, timestampError("timestamp is invalid", s)
 	}
-	day, er2 := strconv.Atoi(s[8:10])
-	if er2 != nil {
-		return time.Now().UTC(), er2
+	if month < 1 || month > 12 || day < 1 || day > daysIn(month, year) || hour > 23 || minute > 59 || sec > 60 {
+		return time.Time{}, timestampError("timestamp is out of range", s)
 	}
-	hour, er3 := strconv.Atoi(s[11:13])
-	if er3 != nil {
-		return time.Now().UTC(), er3
+	i := 19
+	var ns int64
+	if i < len(s) && s[i] == '.' {
+		f, digits := parseFraction(s[i+1:])
+		if digits == 0 {
+			return time.Time{}, timestampError("timestamp fraction is invalid", s)
+		}
+		ns = f
+		i += 1 + digits
 	}
-	min, er4 := strconv.Atoi(s[14:16])
-	if er4 != nil {
-		return time.Now().UTC(), er4
+	var offset int
+	switch {
+	case i == len(s):
+		if rfc {
+			return time.Time{}, timestampError("timestamp offset is missing", s)
+		}
+	case s[i] == 'Z' || s[i] == 'z':
+		i++
+	case s[i] == '+' || s[i] == '-':
+		n, ok := parseOffset(s[i+1:], rfc)
+		if !ok {
+			return time.Time{}, timestampError("timestamp offset is invalid", s)
+		}
+		offset = n
+		if s[i] == '-' {
+			offset = -offset
+		}
+		i = len(s)
 	}
-	sec, er5 := strconv.Atoi(s[17:19])
-	if er5 != nil {
-		return time.Now().UTC(), er5
+	if i != len(s) {
+		return time.Time{}, timestampError("timestamp is invalid", s)
 	}
-	ns, er6 := strconv.Atoi(s[20:26])
-	if er6 != nil {
-		return time.Now().UTC(), er6
+	t := time.Date(int(year), time.Month(month), int(day), int(hour), int(minute), int(sec), int(ns), time.UTC)
+	return t.Add(-time.Duration(offset) * time.Second), nil
+}
+
+// parseOffset - hh:mm, and for SQL also hh and hhmm, returned in seconds
+func parseOffset(s string, rfc bool) (int, bool) {
+	var h, m uint64
+	var ok1, ok2 = false, true
+	switch {
+	case len(s) == 5 && s[2] == ':':
+		h, ok1 = parseDigits(s[0:2])
+		m, ok2 = parseDigits(s[3:5])
+	case !rfc && len(s) == 2:
+		h, ok1 = parseDigits(s)
+	case !rfc && len(s) == 4:
+		h, ok1 = parseDigits(s[0:2])
+		m, ok2 = parseDigits(s[2:4])
+	}
+	if !ok1 || !ok2 || h > 23 || m > 59 {
+		return 0, false
+	}
+	return int(h*3600 + m*60), true
+}
+
+// parseDigits - parse a string of digits
+func parseDigits(s string) (uint64, bool) {
+	if s == "" {
+		return 0, false
+	}
+	var n uint64
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c < '0' || c > '9' {
+			return 0, false
+		}
+		n = n*10 + uint64(c-'0')
+	}
+	return n, true
+}
+
+// parseFraction - parse leading fractional second digits as nanoseconds, digits beyond nanoseconds are truncated
+func parseFraction(s string) (ns int64, digits int) {
+	scale := int64(1e8)
+	for ; digits < len(s) && s[digits] >= '0' && s[digits] <= '9'; digits++ {
+		ns += int64(s[digits]-'0') * scale
+		scale /= 10
+	}
+	return ns, digits
+}
+
+func isEpoch(s string) bool {
+	t := s
+	if t != "" && t[0] == '-' {
+		t = t[1:]
+	}
+	if t == "" || t[0] == '.' {
+		return false
 	}
-	return time.Date(year, time.Month(month), day, hour, min, sec, ns*1000, time.UTC), nil
+	dot := false
+	for i := 0; i < len(t); i++ {
+		switch {
+		case t[i] >= '0' && t[i] <= '9':
+		case t[i] == '.' && !dot && i < len(t)-1:
+			dot = true
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func daysIn(month, year uint64) uint64 {
+	switch month {
+	case 2:
+		if year%4 == 0 && (year%100 != 0 || year%400 == 0) {
+			return 29
+		}
+		return 28
+	case 4, 6, 9, 11:
+		return 30
+	}
+	return 31
+}
+
+func timestampError(msg, s string) error {
+	return errors.New(fmt.Sprintf("%v: [%v]", msg, s))
 }
 
 func parseYMD(s string) (y int, m int, d int, err error) {
+	if len(s) < 10 {
+		return 0, 0, 0, timestampError("timestamp date is invalid", s)
+	}
 	y, err = strconv.Atoi(s[0:4])
 	if err != nil {
 		return
@@ -87,6 +254,9 @@ func parseYMD(s string) (y int, m int, d int, err error) {
 }
 
 func parseHMSM(s string) (h int, m int, sec int, ms int, err error) {
+	if len(s) < 23 {
+		return 0, 0, 0, 0, timestampError("timestamp time is invalid", s)
+	}
 	h, err = strconv.Atoi(s[11:13])
 	if err != nil {
 		return
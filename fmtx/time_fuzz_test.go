@@ -0,0 +1,62 @@
+package fmtx
+
+import (
+	"testing"
+	"time"
+)
+
+func FuzzParseRFC3339(f *testing.F) {
+	for _, s := range []string{"2024-03-01T18:23:50.205Z", "2024-03-01T13:23:50-05:00", "2024-02-29T23:59:60.123456789+14:00", "2024-03-01T18:23:50", "", "2024-03-01T"} {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		ts, err := ParseRFC3339(s)
+		if err != nil {
+			return
+		}
+		if ts.Location() != time.UTC {
+			t.Errorf("ParseRFC3339(%q) location = %v, want UTC", s, ts.Location())
+		}
+		// A parsed time must round trip, unless the offset moved it out of the four digit year range
+		if y := ts.Year(); y >= 0 && y <= 9999 {
+			ts2, err := ParseRFC3339(ts.Format(time.RFC3339Nano))
+			if err != nil || !ts2.Equal(ts) {
+				t.Errorf("ParseRFC3339(%q) round trip = %v, %v, want %v", s, ts2, err, ts)
+			}
+		}
+		// The standard library must agree when it can parse the timestamp
+		if std, err := time.Parse(time.RFC3339Nano, s); err == nil && !std.Equal(ts) {
+			t.Errorf("ParseRFC3339(%q) = %v, want %v", s, ts, std)
+		}
+	})
+}
+
+func FuzzParseSQLTimestamp(f *testing.F) {
+	for _, s := range []string{"2023-04-14 14:14:45", "2023-04-14 14:14:45.522460", "2023-04-14 14:14:45+02", "2023-04-14 14:14:45-01:30", "2023-04-14 1"} {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		ts, err := ParseSQLTimestamp(s)
+		if err != nil {
+			return
+		}
+		if std, err := time.Parse("2006-01-02 15:04:05.999999999", s); err == nil && !std.Equal(ts) {
+			t.Errorf("ParseSQLTimestamp(%q) = %v, want %v", s, ts, std)
+		}
+	})
+}
+
+func FuzzParseEpoch(f *testing.F) {
+	for _, s := range []string{"1712930085", "1712930085.522", "1712930085522", "-86400", "-", "."} {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		ts, err := ParseEpoch(s)
+		if err != nil {
+			return
+		}
+		if ts.Location() != time.UTC {
+			t.Errorf("ParseEpoch(%q) location = %v, want UTC", s, ts.Location())
+		}
+	})
+}
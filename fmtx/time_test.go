@@ -2,6 +2,7 @@ package fmtx
 
 import (
 	"fmt"
+	"testing"
 	"time"
 )
 
@@ -44,3 +45,74 @@ func ExampleParseTimestamp() {
 	//test: ParseTimestamp("2024-03-01T18:23:50.205Z") -> [<nil>] [err:2024-03-01T18:23:50.205Z] [equal:true]
 
 }
+
+func ExampleParseRFC3339() {
+	for _, s := range []string{"2024-03-01T18:23:50Z", "2024-03-01T18:23:50.2Z", "2024-03-01T18:23:50.205123456789z", "2024-03-01t13:23:50.205-05:00", "2024-02-29T23:30:00+05:30",
+		"", "2024-03-01", "2024-03-01T18:23:50", "2024-02-30T18:23:50Z", "2024-03-01T18:23:50.Z", "2024-03-01T18:23:50+0500", "2024-03-01 18:23:50Z"} {
+		t, err := ParseRFC3339(s)
+		fmt.Printf("test: ParseRFC3339(\"%v\") -> [%v] [err:%v]\n", s, t.Format(time.RFC3339Nano), err)
+	}
+
+	//Output:
+	//test: ParseRFC3339("2024-03-01T18:23:50Z") -> [2024-03-01T18:23:50Z] [err:<nil>]
+	//test: ParseRFC3339("2024-03-01T18:23:50.2Z") -> [2024-03-01T18:23:50.2Z] [err:<nil>]
+	//test: ParseRFC3339("2024-03-01T18:23:50.205123456789z") -> [2024-03-01T18:23:50.205123456Z] [err:<nil>]
+	//test: ParseRFC3339("2024-03-01t13:23:50.205-05:00") -> [2024-03-01T18:23:50.205Z] [err:<nil>]
+	//test: ParseRFC3339("2024-02-29T23:30:00+05:30") -> [2024-02-29T18:00:00Z] [err:<nil>]
+	//test: ParseRFC3339("") -> [0001-01-01T00:00:00Z] [err:timestamp is empty: []]
+	//test: ParseRFC3339("2024-03-01") -> [0001-01-01T00:00:00Z] [err:timestamp is invalid: [2024-03-01]]
+	//test: ParseRFC3339("2024-03-01T18:23:50") -> [0001-01-01T00:00:00Z] [err:timestamp offset is missing: [2024-03-01T18:23:50]]
+	//test: ParseRFC3339("2024-02-30T18:23:50Z") -> [0001-01-01T00:00:00Z] [err:timestamp is out of range: [2024-02-30T18:23:50Z]]
+	//test: ParseRFC3339("2024-03-01T18:23:50.Z") -> [0001-01-01T00:00:00Z] [err:timestamp fraction is invalid: [2024-03-01T18:23:50.Z]]
+	//test: ParseRFC3339("2024-03-01T18:23:50+0500") -> [0001-01-01T00:00:00Z] [err:timestamp offset is invalid: [2024-03-01T18:23:50+0500]]
+	//test: ParseRFC3339("2024-03-01 18:23:50Z") -> [0001-01-01T00:00:00Z] [err:timestamp date and time separator is invalid: [2024-03-01 18:23:50Z]]
+
+}
+
+func ExampleParseSQLTimestamp() {
+	for _, s := range []string{"2023-04-14 14:14:45", "2023-04-14 14:14:45.522460", "2023-04-14 14:14:45.5+02", "2023-04-14 14:14:45-0130", "2023-04-14 14:14"} {
+		t, err := ParseSQLTimestamp(s)
+		fmt.Printf("test: ParseSQLTimestamp(\"%v\") -> [%v] [err:%v]\n", s, t.Format(time.RFC3339Nano), err)
+	}
+
+	//Output:
+	//test: ParseSQLTimestamp("2023-04-14 14:14:45") -> [2023-04-14T14:14:45Z] [err:<nil>]
+	//test: ParseSQLTimestamp("2023-04-14 14:14:45.522460") -> [2023-04-14T14:14:45.52246Z] [err:<nil>]
+	//test: ParseSQLTimestamp("2023-04-14 14:14:45.5+02") -> [2023-04-14T12:14:45.5Z] [err:<nil>]
+	//test: ParseSQLTimestamp("2023-04-14 14:14:45-0130") -> [2023-04-14T15:44:45Z] [err:<nil>]
+	//test: ParseSQLTimestamp("2023-04-14 14:14") -> [0001-01-01T00:00:00Z] [err:timestamp is invalid: [2023-04-14 14:14]]
+
+}
+
+func ExampleParseEpoch() {
+	for _, s := range []string{"1712930085", "1712930085.522", "1712930085522", "-86400", "17129300855221234567", "1712930085.", "12a"} {
+		t, err := ParseEpoch(s)
+		fmt.Printf("test: ParseEpoch(\"%v\") -> [%v] [err:%v]\n", s, t.Format(time.RFC3339Nano), err)
+	}
+
+	//Output:
+	//test: ParseEpoch("1712930085") -> [2024-04-12T13:54:45Z] [err:<nil>]
+	//test: ParseEpoch("1712930085.522") -> [2024-04-12T13:54:45.522Z] [err:<nil>]
+	//test: ParseEpoch("1712930085522") -> [2024-04-12T13:54:45.522Z] [err:<nil>]
+	//test: ParseEpoch("-86400") -> [1969-12-31T00:00:00Z] [err:<nil>]
+	//test: ParseEpoch("17129300855221234567") -> [0001-01-01T00:00:00Z] [err:epoch is out of range: [17129300855221234567]]
+	//test: ParseEpoch("1712930085.") -> [0001-01-01T00:00:00Z] [err:epoch is invalid: [1712930085.]]
+	//test: ParseEpoch("12a") -> [0001-01-01T00:00:00Z] [err:epoch is invalid: [12a]]
+
+}
+
+func ExampleParseTimestamp_formats() {
+	for _, s := range []string{"2024-03-01T18:23:50.205Z", "2024-03-01 18:23:50.205", "1709317430205"} {
+		t, err := ParseTimestamp(s)
+		fmt.Printf("test: ParseTimestamp(\"%v\") -> [%v] [err:%v]\n", s, FmtRFC3339Millis(t), err)
+	}
+	allocs := testing.AllocsPerRun(100, func() { ParseRFC3339("2024-03-01T13:23:50.205-05:00") })
+	fmt.Printf("test: ParseRFC3339() -> [allocs:%v]\n", allocs)
+
+	//Output:
+	//test: ParseTimestamp("2024-03-01T18:23:50.205Z") -> [2024-03-01T18:23:50.205Z] [err:<nil>]
+	//test: ParseTimestamp("2024-03-01 18:23:50.205") -> [2024-03-01T18:23:50.205Z] [err:<nil>]
+	//test: ParseTimestamp("1709317430205") -> [2024-03-01T18:23:50.205Z] [err:<nil>]
+	//test: ParseRFC3339() -> [allocs:0]
+
+}
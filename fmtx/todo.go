@@ -1,3 +1 @@
 package fmtx
-
-// Need to add to time parsing for things like: 2m35sec, 6hr23m
@@ -0,0 +1,332 @@
+package httpx
+
+import (
+	"github.com/appellative-ai/common/core"
+	"github.com/appellative-ai/common/fmtx"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// AccessLogFormat - access log line format
+type AccessLogFormat int
+
+const (
+	AccessLogCommon   AccessLogFormat = iota // Common Log Format
+	AccessLogCombined                        // Combined Log Format, Common with the Referer and User-Agent
+	AccessLogLogfmt                          // logfmt key=value pairs
+	AccessLogJson                            // JSON object per line
+)
+
+// AccessLogField - optional access log fields
+type AccessLogField int
+
+const (
+	AccessLogUpstream   AccessLogField = 1 << iota // Host of the request URL
+	AccessLogBytes                                 // Response Content-Length, always included in Common and Combined, where 0 is "-"
+	AccessLogEncoding                              // Response Content-Encoding
+	AccessLogTimeout                               // Timeout in milliseconds
+	AccessLogBudget                                // Remaining deadline budget in milliseconds, when the request has one
+	AccessLogStatusText                            // Status text from core.HttpStatus
+)
+
+const (
+	accessLogRedacted  = "REDACTED"
+	accessLogClfTime   = "02/Jan/2006:15:04:05 -0700"
+	accessLogMissing   = "-"
+	referer            = "Referer"
+	userAgent          = "User-Agent"
+	accessLogBufferCap = 512
+)
+
+// AccessLogConfig - access log configuration. Headers are request headers that are logged, and header values
+// in Redact are replaced, including the Referer and User-Agent in the Combined format. A nil Writer is os.Stdout.
+type AccessLogConfig struct {
+	Format  AccessLogFormat
+	Fields  AccessLogField
+	Headers []string
+	Redact  []string
+	Writer  io.Writer
+}
+
+// AccessLogger - a Logger writing one access log line per request. Common and Combined lines are followed by
+// any configured fields and headers as logfmt pairs.
+type AccessLogger struct {
+	mu      sync.Mutex
+	format  AccessLogFormat
+	fields  AccessLogField
+	headers []string
+	redact  map[string]bool
+	w       io.Writer
+	buf     []byte
+	err     error
+}
+
+var _ Logger = (*AccessLogger)(nil)
+
+// NewAccessLogger - create an access logger
+func NewAccessLogger(cfg AccessLogConfig) *AccessLogger {
+	a := new(AccessLogger)
+	a.format = cfg.Format
+	a.fields = cfg.Fields
+	for _, h := range cfg.Headers {
+		a.headers = append(a.headers, http.CanonicalHeaderKey(h))
+	}
+	a.redact = make(map[string]bool)
+	for _, h := range cfg.Redact {
+		a.redact[http.CanonicalHeaderKey(h)] = true
+	}
+	a.w = cfg.Writer
+	if a.w == nil {
+		a.w = os.Stdout
+	}
+	a.buf = make([]byte, 0, accessLogBufferCap)
+	return a
+}
+
+// Log - Logger interface, write an access log line. A nil response is logged with a status of 0, and a write
+// error is available from Err.
+func (a *AccessLogger) Log(start time.Time, duration time.Duration, routeName string, req *http.Request, resp *http.Response, timeout time.Duration) {
+	if req == nil {
+		return
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.buf = a.AppendLog(a.buf[:0], start, duration, routeName, req, resp, timeout)
+	if _, err := a.w.Write(a.buf); err != nil {
+		a.err = err
+	}
+}
+
+// Err - the last error writing an access log line
+func (a *AccessLogger) Err() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.err
+}
+
+// AppendLog - append an access log line, including the trailing newline
+func (a *AccessLogger) AppendLog(buf []byte, start time.Time, duration time.Duration, routeName string, req *http.Request, resp *http.Response, timeout time.Duration) []byte {
+	e := a.newEntry(start, duration, routeName, req, resp, timeout)
+	switch a.format {
+	case AccessLogLogfmt:
+		buf = a.appendLogfmt(buf, e)
+	case AccessLogJson:
+		buf = a.appendJson(buf, e)
+	default:
+		buf = a.appendClf(buf, e)
+	}
+	return append(buf, '\n')
+}
+
+type accessLogEntry struct {
+	start    time.Time
+	duration time.Duration
+	route    string
+	req      *http.Request
+	resp     *http.Response
+	timeout  time.Duration
+	status   int
+	bytes    int64
+	budget   time.Duration
+	isBudget bool
+}
+
+func (a *AccessLogger) newEntry(start time.Time, duration time.Duration, routeName string, req *http.Request, resp *http.Response, timeout time.Duration) *accessLogEntry {
+	e := &accessLogEntry{start: start, duration: duration, route: routeName, req: req, resp: resp, timeout: timeout, bytes: -1}
+	if resp != nil {
+		e.status = resp.StatusCode
+		e.bytes = resp.ContentLength
+	}
+	if d, ok := req.Context().Deadline(); ok {
+		e.budget, e.isBudget = d.Sub(start.Add(duration)), true
+	} else if d, ok := core.RequestTimeout(req.Header); ok {
+		e.budget, e.isBudget = d-duration, true
+	}
+	if e.budget < 0 {
+		e.budget = 0
+	}
+	return e
+}
+
+func (a *AccessLogger) header(h http.Header, name string) string {
+	v := h.Get(name)
+	if v != "" && a.redact[name] {
+		return accessLogRedacted
+	}
+	return v
+}
+
+func (a *AccessLogger) appendClf(buf []byte, e *accessLogEntry) []byte {
+	buf = append(buf, remoteHost(e.req.RemoteAddr)...)
+	buf = append(buf, " - "...)
+	user := accessLogMissing
+	if e.req.URL != nil && e.req.URL.User != nil && e.req.URL.User.Username() != "" {
+		user = e.req.URL.User.Username()
+	}
+	buf = appendClfValue(buf, user)
+	buf = append(buf, " ["...)
+	buf = e.start.AppendFormat(buf, accessLogClfTime)
+	buf = append(buf, "] \""...)
+	buf = appendClfValue(buf, e.req.Method+" "+requestUri(e.req)+" "+e.req.Proto)
+	buf = append(buf, "\" "...)
+	buf = strconv.AppendInt(buf, int64(e.status), 10)
+	buf = append(buf, ' ')
+	// As in Apache %b, a zero or unknown length is logged as "-"
+	if e.bytes <= 0 {
+		buf = append(buf, accessLogMissing...)
+	} else {
+		buf = strconv.AppendInt(buf, e.bytes, 10)
+	}
+	if a.format == AccessLogCombined {
+		for _, name := range []string{referer, userAgent} {
+			buf = append(buf, " \""...)
+			v := a.header(e.req.Header, name)
+			if v == "" {
+				v = accessLogMissing
+			}
+			buf = appendClfValue(buf, v)
+			buf = append(buf, '"')
+		}
+	}
+	a.fieldPairs(e, func(name, value string, _ bool) {
+		buf = append(buf, ' ')
+		buf = appendLogfmtPair(buf, name, value)
+	})
+	return buf
+}
+
+func (a *AccessLogger) appendLogfmt(buf []byte, e *accessLogEntry) []byte {
+	first := true
+	a.pairs(e, func(name, value string, _ bool) {
+		if !first {
+			buf = append(buf, ' ')
+		}
+		first = false
+		buf = appendLogfmtPair(buf, name, value)
+	})
+	return buf
+}
+
+func (a *AccessLogger) appendJson(buf []byte, e *accessLogEntry) []byte {
+	j := fmtx.NewJsonWriter(buf)
+	j.BeginObject()
+	a.pairs(e, func(name, value string, number bool) {
+		if number {
+			j.Name(name).WriteRaw([]byte(value))
+		} else {
+			j.StringField(name, value)
+		}
+	})
+	j.EndObject()
+	return j.Bytes()
+}
+
+// pairs - all fields in order, formatted as strings, number is set for numeric values
+func (a *AccessLogger) pairs(e *accessLogEntry, fn func(name, value string, number bool)) {
+	fn("time", fmtx.FmtRFC3339Millis(e.start), false)
+	if e.route != "" {
+		fn("route", e.route, false)
+	}
+	fn("method", e.req.Method, false)
+	fn("host", requestHost(e.req), false)
+	fn("uri", requestUri(e.req), false)
+	fn("proto", e.req.Proto, false)
+	fn("status", strconv.Itoa(e.status), true)
+	fn("duration_ms", strconv.FormatInt(e.duration.Milliseconds(), 10), true)
+	a.fieldPairs(e, fn)
+}
+
+// fieldPairs - the configured fields and headers
+func (a *AccessLogger) fieldPairs(e *accessLogEntry, fn func(name, value string, number bool)) {
+	if a.fields&AccessLogStatusText != 0 && e.resp != nil {
+		fn("status_text", core.HttpStatus(e.status), false)
+	}
+	if a.fields&AccessLogUpstream != 0 && e.req.URL != nil && e.req.URL.Host != "" {
+		fn("upstream", e.req.URL.Host, false)
+	}
+	if a.fields&AccessLogBytes != 0 && a.format != AccessLogCommon && a.format != AccessLogCombined && e.bytes >= 0 {
+		fn("bytes", strconv.FormatInt(e.bytes, 10), true)
+	}
+	if a.fields&AccessLogEncoding != 0 && e.resp != nil {
+		if enc := e.resp.Header.Get(ContentEncoding); enc != "" {
+			fn("encoding", enc, false)
+		}
+	}
+	if a.fields&AccessLogTimeout != 0 && e.timeout > 0 {
+		fn("timeout_ms", strconv.FormatInt(e.timeout.Milliseconds(), 10), true)
+	}
+	if a.fields&AccessLogBudget != 0 && e.isBudget {
+		fn("budget_ms", strconv.FormatInt(e.budget.Milliseconds(), 10), true)
+	}
+	for _, name := range a.headers {
+		if v := a.header(e.req.Header, name); v != "" {
+			fn(strings.ToLower(name), v, false)
+		}
+	}
+}
+
+func remoteHost(addr string) string {
+	if addr == "" {
+		return accessLogMissing
+	}
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
+
+func requestHost(r *http.Request) string {
+	if r.Host != "" {
+		return r.Host
+	}
+	if r.URL != nil {
+		return r.URL.Host
+	}
+	return ""
+}
+
+func requestUri(r *http.Request) string {
+	if r.RequestURI != "" {
+		return r.RequestURI
+	}
+	if r.URL != nil {
+		return r.URL.RequestURI()
+	}
+	return accessLogMissing
+}
+
+// appendClfValue - escape quotes, backslashes and control characters, as in Apache access logs
+func appendClfValue(buf []byte, s string) []byte {
+	const hex = "0123456789abcdef"
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '"' || c == '\\':
+			buf = append(buf, '\\', c)
+		case c < 0x20 || c == 0x7f:
+			buf = append(buf, '\\', 'x', hex[c>>4], hex[c&0xf])
+		default:
+			buf = append(buf, c)
+		}
+	}
+	return buf
+}
+
+// appendLogfmtPair - a value is quoted if it is empty, or contains a space, quote, equals sign or control character
+func appendLogfmtPair(buf []byte, name, value string) []byte {
+	buf = append(buf, name...)
+	buf = append(buf, '=')
+	if value == "" || strings.IndexFunc(value, func(r rune) bool {
+		return r <= ' ' || r == '"' || r == '=' || r == '\\' || r == 0x7f || r == utf8.RuneError
+	}) >= 0 {
+		return strconv.AppendQuote(buf, value)
+	}
+	return append(buf, value...)
+}
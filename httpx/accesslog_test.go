@@ -0,0 +1,82 @@
+package httpx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+)
+
+func ExampleNewAccessLogger() {
+	start := time.Date(2024, 6, 3, 18, 4, 5, 0, time.UTC)
+	req, _ := http.NewRequest(http.MethodGet, "https://www.google.com/search?q=golang", nil)
+	req.RemoteAddr = "10.1.1.1:51234"
+	req.Header.Set("Authorization", "Bearer token")
+	req.Header.Set("User-Agent", "curl/8.4 \"test\"")
+	req.Header.Set("X-Request-Id", "123-456")
+	req.Header.Set("X-Request-Timeout", "250")
+	resp := &http.Response{StatusCode: http.StatusOK, ContentLength: 1024, Header: http.Header{ContentEncoding: {GzipEncoding}}}
+	fields := AccessLogUpstream | AccessLogBytes | AccessLogEncoding | AccessLogTimeout | AccessLogBudget | AccessLogStatusText
+	headers := []string{"x-request-id", "authorization"}
+
+	var logger Logger = NewAccessLogger(AccessLogConfig{Format: AccessLogCommon, Writer: os.Stdout})
+	logger.Log(start, time.Millisecond*50, "search", req, resp, time.Second)
+
+	logger = NewAccessLogger(AccessLogConfig{Format: AccessLogCombined, Headers: headers, Redact: []string{"Authorization"}, Writer: os.Stdout})
+	logger.Log(start, time.Millisecond*50, "search", req, resp, time.Second)
+
+	logger = NewAccessLogger(AccessLogConfig{Format: AccessLogLogfmt, Fields: fields, Headers: headers, Redact: []string{"Authorization"}, Writer: os.Stdout})
+	logger.Log(start, time.Millisecond*50, "search", req, resp, time.Second)
+
+	logger = NewAccessLogger(AccessLogConfig{Format: AccessLogJson, Fields: fields, Headers: headers, Redact: []string{"Authorization"}, Writer: os.Stdout})
+	logger.Log(start, time.Millisecond*50, "search", req, resp, time.Second)
+	logger.Log(start, time.Millisecond*50, "", req, nil, 0)
+
+	//Output:
+	//10.1.1.1 - - [03/Jun/2024:18:04:05 +0000] "GET /search?q=golang HTTP/1.1" 200 1024
+	//10.1.1.1 - - [03/Jun/2024:18:04:05 +0000] "GET /search?q=golang HTTP/1.1" 200 1024 "-" "curl/8.4 \"test\"" x-request-id=123-456 authorization=REDACTED
+	//time=2024-06-03T18:04:05.000Z route=search method=GET host=www.google.com uri="/search?q=golang" proto=HTTP/1.1 status=200 duration_ms=50 status_text=OK upstream=www.google.com bytes=1024 encoding=gzip timeout_ms=1000 budget_ms=200 x-request-id=123-456 authorization=REDACTED
+	//{"time":"2024-06-03T18:04:05.000Z","route":"search","method":"GET","host":"www.google.com","uri":"/search?q=golang","proto":"HTTP/1.1","status":200,"duration_ms":50,"status_text":"OK","upstream":"www.google.com","bytes":1024,"encoding":"gzip","timeout_ms":1000,"budget_ms":200,"x-request-id":"123-456","authorization":"REDACTED"}
+	//{"time":"2024-06-03T18:04:05.000Z","method":"GET","host":"www.google.com","uri":"/search?q=golang","proto":"HTTP/1.1","status":0,"duration_ms":50,"upstream":"www.google.com","budget_ms":200,"x-request-id":"123-456","authorization":"REDACTED"}
+
+}
+
+type failWriter struct{}
+
+func (failWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("write failed")
+}
+
+func ExampleAccessLogger_Log() {
+	start := time.Date(2024, 6, 3, 18, 4, 5, 0, time.UTC)
+	req, _ := http.NewRequest(http.MethodPost, "https://localhost:8080/update", nil)
+	req.RemoteAddr = "10.1.1.1:51234"
+	req.Header.Set("Referer", "https://localhost/home")
+	req.Header.Set("User-Agent", "curl/8.4")
+
+	logger := NewAccessLogger(AccessLogConfig{Format: AccessLogCommon, Writer: os.Stdout})
+	logger.Log(start, time.Millisecond*50, "", req, &http.Response{StatusCode: http.StatusOK, ContentLength: -1}, 0)
+	logger.Log(start, time.Millisecond*50, "", req, &http.Response{StatusCode: http.StatusNoContent, ContentLength: 0}, 0)
+
+	logger = NewAccessLogger(AccessLogConfig{Format: AccessLogCombined, Redact: []string{"referer", "user-agent"}, Writer: os.Stdout})
+	logger.Log(start, time.Millisecond*50, "", req, &http.Response{StatusCode: http.StatusOK, ContentLength: 12}, 0)
+
+	ctx, cancel := context.WithDeadline(context.Background(), start.Add(time.Millisecond*300))
+	defer cancel()
+	logger = NewAccessLogger(AccessLogConfig{Format: AccessLogLogfmt, Fields: AccessLogBytes | AccessLogBudget, Writer: os.Stdout})
+	logger.Log(start, time.Millisecond*50, "", req.WithContext(ctx), &http.Response{StatusCode: http.StatusOK, ContentLength: 0}, 0)
+
+	logger = NewAccessLogger(AccessLogConfig{Format: AccessLogCommon, Writer: failWriter{}})
+	logger.Log(start, time.Millisecond*50, "", req, nil, 0)
+	fmt.Printf("test: Log() [err:%v]\n", logger.Err())
+
+	//Output:
+	//10.1.1.1 - - [03/Jun/2024:18:04:05 +0000] "POST /update HTTP/1.1" 200 -
+	//10.1.1.1 - - [03/Jun/2024:18:04:05 +0000] "POST /update HTTP/1.1" 204 -
+	//10.1.1.1 - - [03/Jun/2024:18:04:05 +0000] "POST /update HTTP/1.1" 200 12 "REDACTED" "REDACTED"
+	//time=2024-06-03T18:04:05.000Z method=POST host=localhost:8080 uri=/update proto=HTTP/1.1 status=200 duration_ms=50 bytes=0 budget_ms=250
+	//test: Log() [err:write failed]
+
+}
@@ -1,10 +1,14 @@
 package httpx
 
 import (
+	"context"
 	"crypto/tls"
 	"errors"
+	"github.com/appellative-ai/common/core"
 	"net/http"
 	"net/url"
+	"slices"
+	"strconv"
 	"time"
 )
 
@@ -15,9 +19,15 @@ const (
 )
 
 var (
-	Client          = http.DefaultClient
-	serverResponse  = serverErrorResponse()
-	timeoutResponse = gatewayTimeoutResponse()
+	// TimeoutMargin - subtracted from the remaining context time for the X-Request-Timeout header, to allow for
+	// the network and response processing
+	TimeoutMargin = time.Millisecond * 5
+
+	// TimeoutHosts - hosts, without ports, that are sent the X-Request-Timeout header. The header is not sent to
+	// other hosts, so internal timing is not leaked to third parties.
+	TimeoutHosts []string
+
+	Client = http.DefaultClient
 )
 
 func init() {
@@ -34,9 +44,25 @@ func init() {
 	}
 }
 
-// Do - process an HTTP request, checking for file:// scheme
+// Do - process an HTTP request, checking for file:// scheme. If the request context has a deadline, the remaining
+// time less the TimeoutMargin is the request budget, and an exhausted budget is not sent. For a host in TimeoutHosts
+// the budget is set as the X-Request-Timeout header of a cloned request.
 func Do(req *http.Request) (resp *http.Response, err error) {
 	// panic if req or URL is nil - should be resolved during testing
+	if _, ok := req.Context().Deadline(); ok {
+		budget := timeout(req.Context()) - TimeoutMargin
+		if budget <= 0 {
+			return gatewayTimeoutResponse(), context.DeadlineExceeded
+		}
+		if slices.Contains(TimeoutHosts, req.URL.Hostname()) {
+			// Clone so the caller's request headers are not changed
+			req = req.Clone(req.Context())
+			if req.Header == nil {
+				req.Header = make(http.Header)
+			}
+			req.Header.Set(core.XRequestTimeout, formatBudget(budget))
+		}
+	}
 	resp, err = Client.Do(req)
 	if resp != nil && resp.Header == nil {
 		resp.Header = make(http.Header)
@@ -46,14 +72,19 @@ func Do(req *http.Request) (resp *http.Response, err error) {
 		var urlErr *url.Error
 		if errors.As(err, &urlErr) {
 			if urlErr.Timeout() {
-				return timeoutResponse, err
+				return gatewayTimeoutResponse(), err
 			}
 		}
-		resp = serverResponse
+		resp = serverErrorResponse()
 	}
 	return
 }
 
+// formatBudget - budget in milliseconds, rounded up so a budget under 1ms is not sent as 0
+func formatBudget(budget time.Duration) string {
+	return strconv.FormatInt(int64((budget+time.Millisecond-1)/time.Millisecond), 10)
+}
+
 func serverErrorResponse() *http.Response {
 	resp := new(http.Response)
 	resp.StatusCode = http.StatusInternalServerError
@@ -3,7 +3,9 @@ package httpx
 import (
 	"context"
 	"fmt"
+	"github.com/appellative-ai/common/core"
 	"net/http"
+	"net/http/httptest"
 	"time"
 )
 
@@ -228,3 +230,40 @@ func ExampleExchangeDo_URLError() {
 	//test: ExchangeDo_Timeout()-ReadAll()-timeout -> [status-code:500] [err:Get "https://www.google2345.com/search?q=golang": tls: first record does not look like a TLS handshake]
 
 }
+
+func ExampleDo_requestTimeout() {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		budget, ok := core.RequestTimeout(r.Header)
+		fmt.Printf("test: RequestTimeout() -> [budget:%v] [ok:%v]\n", budget > time.Millisecond*900 && budget < time.Second, ok)
+	}))
+	defer ts.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, ts.URL, nil)
+	resp, err := Do(req)
+	fmt.Printf("test: Do() -> [status:%v] [err:%v]\n", resp.StatusCode, err)
+
+	TimeoutHosts = []string{req.URL.Hostname()}
+	defer func() { TimeoutHosts = nil }()
+	resp, err = Do(req)
+	fmt.Printf("test: Do() -> [status:%v] [err:%v] [caller-header:%v]\n", resp.StatusCode, err, req.Header.Get(core.XRequestTimeout))
+
+	fmt.Printf("test: formatBudget() -> [300µs:%v] [1ms:%v] [1.2ms:%v]\n", formatBudget(time.Microsecond*300), formatBudget(time.Millisecond), formatBudget(time.Microsecond*1200))
+
+	ctx1, cancel1 := context.WithTimeout(context.Background(), TimeoutMargin/2)
+	defer cancel1()
+	req, _ = http.NewRequestWithContext(ctx1, http.MethodGet, ts.URL, nil)
+	resp, err = Do(req)
+	resp1, _ := Do(req)
+	fmt.Printf("test: Do() -> [status:%v] [err:%v] [new-response:%v]\n", resp.StatusCode, err, resp != resp1)
+
+	//Output:
+	//test: RequestTimeout() -> [budget:false] [ok:false]
+	//test: Do() -> [status:200] [err:<nil>]
+	//test: RequestTimeout() -> [budget:true] [ok:true]
+	//test: Do() -> [status:200] [err:<nil>] [caller-header:]
+	//test: formatBudget() -> [300µs:1] [1ms:1] [1.2ms:2]
+	//test: Do() -> [status:504] [err:context deadline exceeded] [new-response:true]
+
+}
@@ -1,16 +1,11 @@
 package httpx
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
+	"github.com/appellative-ai/common/core"
 	"io"
 	"reflect"
-	"strings"
-)
-
-const (
-	jsonToken = "json"
 )
 
 func writeContent(w io.Writer, content any, contentType string) (length int64, err error) {
@@ -24,39 +19,36 @@ func writeContent(w io.Writer, content any, contentType string) (length int64, e
 		cnt, err = w.Write(ptr)
 	case string:
 		cnt, err = w.Write([]byte(ptr))
-	case error:
-		cnt, err = w.Write([]byte(ptr.Error()))
-	case io.Reader:
-		var buf []byte
-		var err1 error
-
-		buf, err1 = readAll(ptr)
-		if err1 != nil {
-			return 0, err1
+	case *core.Status:
+		if ptr == nil {
+			return 0, nil
 		}
-		cnt, err = w.Write(buf)
-	case io.ReadCloser:
 		var buf []byte
-		var err1 error
-
-		buf, err1 = readAll(ptr)
-		_ = ptr.Close()
-		if err1 != nil {
-			return 0, err1
+		buf, err = ptr.MarshalProblem()
+		if err != nil {
+			return
 		}
 		cnt, err = w.Write(buf)
+	case error:
+		cnt, err = w.Write([]byte(ptr.Error()))
+	case io.Reader:
+		// Stream the content, and close if a closer
+		length, err = io.Copy(w, ptr)
+		if c, ok := ptr.(io.Closer); ok {
+			_ = c.Close()
+		}
+		return length, err
 	default:
-		if strings.Contains(contentType, jsonToken) {
-			var buf []byte
-
-			buf, err = json.Marshal(content)
-			if err != nil {
-				return
-			}
-			cnt, err = w.Write(buf)
-		} else {
+		codec, ok := core.MarshalCodec(contentType, true)
+		if !ok {
 			return 0, errors.New(fmt.Sprintf("error: content type is invalid: %v", reflect.TypeOf(ptr)))
 		}
+		var buf []byte
+		buf, err = codec.Marshal(content)
+		if err != nil {
+			return
+		}
+		cnt, err = w.Write(buf)
 	}
 	return int64(cnt), err
 }
@@ -76,8 +76,8 @@ func ExampleWriteContent_Reader() {
 	fmt.Printf("test: writeContent(io.ReadCloser) -> [cnt:%v] [write-status:%v] [body:%v] [read-status:%v]\n", cnt, status, len(buf), status0)
 
 	//Output:
-	//test: writeContent(io.Reader) -> [cnt:188] [write-status:<nil>] [body:188] [read-status:<nil>]
-	//test: writeContent(io.ReadCloser) -> [cnt:188] [write-status:<nil>] [body:188] [read-status:<nil>]
+	//test: writeContent(io.Reader) -> [cnt:178] [write-status:<nil>] [body:178] [read-status:<nil>]
+	//test: writeContent(io.ReadCloser) -> [cnt:178] [write-status:<nil>] [body:178] [read-status:<nil>]
 
 }
 
@@ -111,3 +111,24 @@ func ExampleWriteContent_Json() {
 	//test: writeContent(httpx.testActivity) -> [cnt:204] [write-status:<nil>] [body:{"ActivityID":"123456","ActivityType":"action","Agent":"Controller","AgentUri":"https://somehost.com/id","Assignment":"case #","Controller":"egress","Behavior":"timeout","Description":"decreased timeout"}] [read-status:<nil>]
 
 }
+
+func Example_writeContentCodec() {
+	content := map[string]string{"name": "rater", "version": "1.4.2"}
+
+	// vendor JSON
+	rec := httptest.NewRecorder()
+	cnt, status := writeContent(rec, content, "application/vnd.acme+json; charset=utf-8")
+	buf, status0 := readAll(rec.Result().Body)
+	fmt.Printf("test: writeContent(map) -> [cnt:%v] [write-status:%v] [body:%v] [read-status:%v]\n", cnt, status, string(buf), status0)
+
+	// form
+	rec = httptest.NewRecorder()
+	cnt, status = writeContent(rec, content, "application/x-www-form-urlencoded")
+	buf, status0 = readAll(rec.Result().Body)
+	fmt.Printf("test: writeContent(map) -> [cnt:%v] [write-status:%v] [body:%v] [read-status:%v]\n", cnt, status, string(buf), status0)
+
+	//Output:
+	//test: writeContent(map) -> [cnt:34] [write-status:<nil>] [body:{"name":"rater","version":"1.4.2"}] [read-status:<nil>]
+	//test: writeContent(map) -> [cnt:24] [write-status:<nil>] [body:name=rater&version=1.4.2] [read-status:<nil>]
+
+}
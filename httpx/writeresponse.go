@@ -1,18 +1,29 @@
 package httpx
 
 import (
+	"github.com/appellative-ai/common/core"
 	"github.com/appellative-ai/common/iox"
 	"net/http"
 )
 
 // WriteResponse - write a httpx.Response, utilizing the content, status code, and headers
-// Content types supported: []byte, string, error, io.Reader, io.ReadCloser. Other types will be treated as JSON and serialized, if
-// the headers content type is JSON. If not JSON, then an error will be raised.
+// Content types supported: []byte, string, error, io.Reader, io.ReadCloser. Other types will be serialized by the core codec
+// registered for the headers content type. If there is no codec, then an error will be raised.
+// A *core.Status that is not OK is written as an RFC 9457 application/problem+json document, with the status code
+// from core.HttpCode, and an OK status is written with the status code and no content.
 func WriteResponse(w http.ResponseWriter, headers any, statusCode int, content any, reqHeader http.Header) (contentLength int64) {
 	if statusCode == 0 {
 		statusCode = http.StatusOK
 	}
 	SetHeaders(w, headers)
+	if s, ok := content.(*core.Status); ok && s != nil {
+		if s.OK() {
+			content = nil
+		} else {
+			statusCode = core.HttpCode(s.Code)
+			w.Header().Set(ContentType, core.ContentTypeProblemJson)
+		}
+	}
 	if content == nil {
 		w.WriteHeader(statusCode)
 		return 0
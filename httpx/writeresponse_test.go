@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"compress/gzip"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"github.com/appellative-ai/common/core"
 	"github.com/appellative-ai/common/iox"
 	"net/http"
 	"net/http/httptest"
@@ -147,7 +149,7 @@ func ExampleWriteResponse_Encoding() {
 	h = make(http.Header)
 	h.Add(ContentType, ContentTypeJson)
 	//h.Add(AcceptEncoding, AcceptEncodingValue)
-	//h.Add(ContentEncoding, NoneEncoding)
+	h.Add(ContentEncoding, NoneEncoding)
 	rec = httptest.NewRecorder()
 	WriteResponse(rec, h, 0, activityList, CreateAcceptEncodingHeader())
 	buf, status0 = readAll(rec.Result().Body)
@@ -158,3 +160,26 @@ func ExampleWriteResponse_Encoding() {
 	//test: WriteResponse(w,httpx.Header,0,[]activity) -> [read-all:<nil>] [buf:text/plain; charset=utf-8][header:map[Content-Encoding:[none] Content-Type:[application/json]]]
 
 }
+
+func ExampleWriteResponse_Status() {
+	h := make(http.Header)
+	h.Add(ContentType, ContentTypeJson)
+
+	rec := httptest.NewRecorder()
+	status := core.NewStatus(core.StatusUnavailable, errors.New("backend is down")).SetLocation("acme:orders:agent/backend")
+	WriteResponse(rec, h, http.StatusOK, status, nil)
+	buf, status0 := readAll(rec.Result().Body)
+	fmt.Printf("test: WriteResponse(w,httpx.Header,OK,*core.Status) -> [status-code:%v] [content-type:%v] [read-all:%v]\n", rec.Result().StatusCode, rec.Result().Header.Get(ContentType), status0)
+	fmt.Printf("test: WriteResponse(w,httpx.Header,OK,*core.Status) -> %v\n", string(buf))
+
+	rec = httptest.NewRecorder()
+	WriteResponse(rec, h, http.StatusNoContent, core.StatusOK, nil)
+	buf, status0 = readAll(rec.Result().Body)
+	fmt.Printf("test: WriteResponse(w,httpx.Header,NoContent,core.StatusOK) -> [status-code:%v] [content-type:%v] [content:%v]\n", rec.Result().StatusCode, rec.Result().Header.Get(ContentType), len(buf))
+
+	//Output:
+	//test: WriteResponse(w,httpx.Header,OK,*core.Status) -> [status-code:503] [content-type:application/problem+json] [read-all:<nil>]
+	//test: WriteResponse(w,httpx.Header,OK,*core.Status) -> {"code":14,"status":503,"title":"Unavailable","trace":["acme:orders:agent/backend"],"type":"about:blank"}
+	//test: WriteResponse(w,httpx.Header,NoContent,core.StatusOK) -> [status-code:204] [content-type:application/json] [content:0]
+
+}
@@ -0,0 +1,42 @@
+package httpx
+
+import (
+	"github.com/appellative-ai/common/core"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// hopHeaders - RFC 9110 section 7.6.1 connection specific headers, and upstream cookies, that are not forwarded
+var hopHeaders = []string{"Connection", "Keep-Alive", "Proxy-Connection", "Proxy-Authenticate", "Proxy-Authorization",
+	"Te", "Trailer", "Transfer-Encoding", "Upgrade", "Set-Cookie"}
+
+// WriteResult - write a core.Result with WriteResponse. A successful result value is written with the result
+// headers and status code, and a value without a content type is written as JSON. An unsuccessful result
+// status is written as application/problem+json. Hop-by-hop headers, headers named in Connection, and Set-Cookie
+// are not written.
+func WriteResult[T any](w http.ResponseWriter, r core.Result[T], reqHeader http.Header) (contentLength int64) {
+	var connection []string
+	for _, v := range r.Header.Values("Connection") {
+		for _, name := range strings.Split(v, ",") {
+			connection = append(connection, http.CanonicalHeaderKey(strings.TrimSpace(name)))
+		}
+	}
+	for k, v := range r.Header {
+		k = http.CanonicalHeaderKey(k)
+		// The content is re-encoded
+		if k == "Content-Length" || k == ContentEncoding || slices.Contains(hopHeaders, k) || slices.Contains(connection, k) {
+			continue
+		}
+		w.Header()[k] = v
+	}
+	if r.OK() && w.Header().Get(ContentType) == "" {
+		switch any(r.Value).(type) {
+		case string, []byte, io.Reader:
+		default:
+			w.Header().Set(ContentType, ContentTypeJson)
+		}
+	}
+	return WriteResponse(w, nil, r.StatusCode(), r.Content(), reqHeader)
+}
@@ -0,0 +1,34 @@
+package httpx
+
+import (
+	"errors"
+	"fmt"
+	"github.com/appellative-ai/common/core"
+	"net/http"
+	"net/http/httptest"
+)
+
+func ExampleWriteResult() {
+	type address struct {
+		City  string `json:"city"`
+		State string `json:"state"`
+	}
+	rec := httptest.NewRecorder()
+	WriteResult(rec, core.NewResult(address{City: "Frisco", State: "TX"}, http.Header{"X-Request-Id": {"123"}}), nil)
+	fmt.Printf("test: WriteResult() -> [status:%v] [content-type:%v] [request-id:%v] %v\n", rec.Code, rec.Header().Get(ContentType), rec.Header().Get("X-Request-Id"), rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	h := http.Header{"Connection": {"keep-alive, X-Hop"}, "Keep-Alive": {"timeout=5"}, "Transfer-Encoding": {"chunked"}, "X-Hop": {"1"}, "Set-Cookie": {"session=abc"}, "X-Request-Id": {"456"}}
+	WriteResult(rec, core.NewResult("hello", h), nil)
+	fmt.Printf("test: WriteResult() -> [status:%v] %v\n", rec.Code, rec.Header())
+
+	rec = httptest.NewRecorder()
+	WriteResult(rec, core.NewErrorResult[address](core.NewStatus(http.StatusNotFound, errors.New("address not found"))), nil)
+	fmt.Printf("test: WriteResult() -> [status:%v] [content-type:%v] %v\n", rec.Code, rec.Header().Get(ContentType), rec.Body.String())
+
+	//Output:
+	//test: WriteResult() -> [status:200] [content-type:application/json] [request-id:123] {"city":"Frisco","state":"TX"}
+	//test: WriteResult() -> [status:200] map[X-Request-Id:[456]]
+	//test: WriteResult() -> [status:404] [content-type:application/problem+json] {"detail":"address not found","status":404,"title":"Not Found","type":"about:blank"}
+
+}
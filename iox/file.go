@@ -2,11 +2,13 @@ package iox
 
 import (
 	"embed"
+	"errors"
 	"fmt"
 	"io/fs"
 	"net/http"
 	"net/url"
 	"os"
+	"path/filepath"
 	"reflect"
 	"strings"
 )
@@ -59,7 +61,15 @@ func FileName(uri any) string {
 		if len(s) == 0 {
 			return "error: URL is empty"
 		}
-		u, _ := url.Parse(s)
+		// A bracketed host is parsed as an IPv6 address, so the [cwd] host is not a valid URL host
+		prefix := fileScheme + "://" + CwdVariable
+		if strings.HasPrefix(s, prefix) {
+			return fileName(&url.URL{Scheme: fileScheme, Host: CwdVariable, Path: s[len(prefix):]})
+		}
+		u, err := url.Parse(s)
+		if err != nil {
+			return fmt.Sprintf("error: URL is invalid [%v]", err)
+		}
 		return fileName(u)
 	}
 	if u, ok := uri.(*url.URL); ok {
@@ -69,7 +79,10 @@ func FileName(uri any) string {
 }
 
 func fileName(u *url.URL) string {
-	if u == nil || u.Scheme != fileScheme {
+	if u == nil {
+		return "error: URL is nil"
+	}
+	if u.Scheme != fileScheme {
 		return fmt.Sprintf("error: scheme is invalid [%v]", u.Scheme)
 	}
 	name := basePath
@@ -117,3 +130,43 @@ func ReadFileWithEncoding(uri string, h http.Header) ([]byte, error) {
 	}
 	return Decode(buf, h)
 }
+
+// WriteFile - write a file, the content is written to a temporary file that is synced and renamed, so a
+// reader never sees a partial file. The mode of an existing file is kept, and a new file is 0644. Embedded files
+// cannot be written.
+func WriteFile(uri any, buf []byte) error {
+	name := FileName(uri)
+	if strings.HasPrefix(name, "error:") {
+		return errors.New(name)
+	}
+	if strings.HasPrefix(name, embeddedFS[len("file:///"):]) {
+		return errors.New(fmt.Sprintf("error: embedded file system is read only [%v]", name))
+	}
+	f1, err := os.CreateTemp(filepath.Dir(name), filepath.Base(name)+".tmp*")
+	if err != nil {
+		return err
+	}
+	tmp := f1.Name()
+	// CreateTemp uses 0600, so keep the mode of an existing file
+	mode := os.FileMode(0644)
+	if info, err1 := os.Stat(name); err1 == nil {
+		mode = info.Mode().Perm()
+	}
+	err = f1.Chmod(mode)
+	if err == nil {
+		_, err = f1.Write(buf)
+	}
+	if err == nil {
+		err = f1.Sync()
+	}
+	if err1 := f1.Close(); err == nil {
+		err = err1
+	}
+	if err == nil {
+		err = os.Rename(tmp, name)
+	}
+	if err != nil {
+		os.Remove(tmp)
+	}
+	return err
+}
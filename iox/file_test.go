@@ -9,6 +9,7 @@ import (
 	"net/url"
 	"os"
 	"reflect"
+	"strings"
 )
 
 //go:embed ioxtest
@@ -116,6 +117,13 @@ func Example_FileNameError() {
 	name = FileName(req)
 	fmt.Printf("test: FileName(%v) -> [type:%v] [url:%v]\n", s, reflect.TypeOf(req), name)
 
+	name = FileName(s)
+	fmt.Printf("test: FileName(%v) -> [type:%v] [cwd:%v]\n", s, reflect.TypeOf(s), strings.HasSuffix(name, "/ioxtest/test-response.txt") && strings.HasPrefix(name, basePath))
+
+	s = "file://[::1/test-response.txt"
+	name = FileName(s)
+	fmt.Printf("test: FileName(%v) -> [type:%v] [url:%v]\n", s, reflect.TypeOf(s), name)
+
 	//Output:
 	//test: FileName(nil) -> [type:<nil>] [url:error: URL is nil]
 	//test: FileName("") -> [type:string] [url:error: URL is empty]
@@ -123,6 +131,8 @@ func Example_FileNameError() {
 	//test: FileName(https://www.google.com/search?q=golang) -> [type:*url.URL] [url:error: scheme is invalid [https]]
 	//test: FileName(https://www.google.com/search?q=golang) -> [type:*http.Request] [url:error: invalid URL type: *http.Request]
 	//test: FileName(file://[cwd]/ioxtest/test-response.txt) -> [type:*http.Request] [url:error: invalid URL type: *http.Request]
+	//test: FileName(file://[cwd]/ioxtest/test-response.txt) -> [type:string] [cwd:true]
+	//test: FileName(file://[::1/test-response.txt) -> [type:string] [url:error: URL is invalid [parse "file://[::1/test-response.txt": missing ']' in host]]
 
 }
 
@@ -240,3 +250,31 @@ func ExampleReadFileEmbedded() {
 	//test: ReadFileEmbedded("file:///f:/ioxtest/hello-world.txt") -> [buf:Hello World!!] [status:<nil>]
 
 }
+
+func ExampleWriteFile() {
+	uri := "file:///write-file-test.txt"
+	err := WriteFile(uri, []byte("hello world"))
+	defer os.Remove("write-file-test.txt")
+	buf, err1 := ReadFile(uri)
+	fmt.Printf("test: WriteFile() -> [err:%v]\n", err)
+	fmt.Printf("test: ReadFile() -> %v [err:%v]\n", string(buf), err1)
+
+	info, _ := os.Stat("write-file-test.txt")
+	fmt.Printf("test: WriteFile() -> [mode:%v]\n", info.Mode().Perm())
+
+	os.Chmod("write-file-test.txt", 0640)
+	WriteFile(uri, []byte("hello again"))
+	info, _ = os.Stat("write-file-test.txt")
+	fmt.Printf("test: WriteFile() -> [mode:%v]\n", info.Mode().Perm())
+
+	err = WriteFile("http://localhost/test.txt", nil)
+	fmt.Printf("test: WriteFile() -> [err:%v]\n", err)
+
+	//Output:
+	//test: WriteFile() -> [err:<nil>]
+	//test: ReadFile() -> hello world [err:<nil>]
+	//test: WriteFile() -> [mode:-rw-r--r--]
+	//test: WriteFile() -> [mode:-rw-r-----]
+	//test: WriteFile() -> [err:error: scheme is invalid [http]]
+
+}
@@ -3,7 +3,9 @@ package messaging
 import (
 	"errors"
 	"fmt"
+	"github.com/appellative-ai/common/core"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 )
@@ -48,6 +50,21 @@ func (e *Exchange) List() []string {
 	return uri
 }
 
+// ListMatch - a list of agent names matching a name pattern, as in common:*:agent/**
+func (e *Exchange) ListMatch(pattern string) ([]string, error) {
+	p, err := core.CompileNamePattern(pattern)
+	if err != nil {
+		return nil, err
+	}
+	var uri []string
+	for _, name := range e.List() {
+		if p.Match(name) {
+			uri = append(uri, name)
+		}
+	}
+	return uri, nil
+}
+
 // Exist - agent exists
 func (e *Exchange) Exist(name string) bool {
 	if name == "" {
@@ -72,6 +89,21 @@ func (e *Exchange) Get(name string) Agent {
 	return nil
 }
 
+// Resolve - find an agent, an un-fragmented name resolves to the newest registered stable semantic version, or
+// the newest pre-release if there is no stable version
+func (e *Exchange) Resolve(name string) Agent {
+	if a := e.Get(name); a != nil {
+		return a
+	}
+	if strings.Contains(name, FragmentIdentifier) {
+		return nil
+	}
+	if latest, ok := core.LatestVersion(e.List(), name); ok {
+		return e.Get(latest)
+	}
+	return nil
+}
+
 // Message - send a message
 func (e *Exchange) Message(msg *Message) (sent bool) {
 	if msg == nil {
@@ -274,6 +274,51 @@ func ExampleListCount() {
 
 }
 
+func ExampleExchange_ListMatch() {
+	ex := NewExchange()
+	for _, uri := range []string{"acme:orders:agent/rater", "acme:orders:agent/rater/v2", "acme:billing:agent/rater#3", "urn:agent-1"} {
+		ex.Register(newTestAgent(uri, nil, nil))
+	}
+
+	list, err := ex.ListMatch("acme:*:agent/rater")
+	fmt.Printf("test: ListMatch() -> : %v [err:%v]\n", list, err)
+
+	list, err = ex.ListMatch("acme:orders:agent/**")
+	fmt.Printf("test: ListMatch() -> : %v [err:%v]\n", list, err)
+
+	list, err = ex.ListMatch("acme:orders")
+	fmt.Printf("test: ListMatch() -> : %v [err:%v]\n", list, err)
+
+	//Output:
+	//test: ListMatch() -> : [acme:billing:agent/rater#3 acme:orders:agent/rater] [err:<nil>]
+	//test: ListMatch() -> : [acme:orders:agent/rater acme:orders:agent/rater/v2] [err:<nil>]
+	//test: ListMatch() -> : [] [err:name pattern is missing colon after domain: [acme:orders]]
+
+}
+
+func ExampleExchange_Resolve() {
+	ex := NewExchange()
+	for _, uri := range []string{"acme:billing:agent/rater#1.4.2", "acme:billing:agent/rater#1.10.0", "acme:billing:agent/rater#2.0.0-beta.1", "acme:orders:agent/rater"} {
+		ex.Register(newTestAgent(uri, nil, nil))
+	}
+
+	for _, name := range []string{"acme:billing:agent/rater", "acme:billing:agent/rater#1.4.2", "acme:billing:agent/rater#3.0.0", "acme:orders:agent/rater"} {
+		a := ex.Resolve(name)
+		if a == nil {
+			fmt.Printf("test: Resolve(\"%v\") -> : <nil>\n", name)
+			continue
+		}
+		fmt.Printf("test: Resolve(\"%v\") -> : %v\n", name, a.Name())
+	}
+
+	//Output:
+	//test: Resolve("acme:billing:agent/rater") -> : acme:billing:agent/rater#1.10.0
+	//test: Resolve("acme:billing:agent/rater#1.4.2") -> : acme:billing:agent/rater#1.4.2
+	//test: Resolve("acme:billing:agent/rater#3.0.0") -> : <nil>
+	//test: Resolve("acme:orders:agent/rater") -> : acme:orders:agent/rater
+
+}
+
 func _ExampleExchangeOnShutdown() {
 	uri1 := "urn:agent-1"
 	uri2 := "urn:agent-2"
@@ -0,0 +1,166 @@
+package resilience
+
+import (
+	"errors"
+	"fmt"
+	"github.com/appellative-ai/common/core"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// BreakerState - circuit breaker state
+type BreakerState int
+
+const (
+	BreakerClosed   BreakerState = iota // Requests are allowed
+	BreakerOpen                         // Requests are rejected until the open timeout elapses
+	BreakerHalfOpen                     // A limited number of trial requests are allowed
+)
+
+// String - state name
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerClosed:
+		return "closed"
+	case BreakerOpen:
+		return "open"
+	case BreakerHalfOpen:
+		return "half-open"
+	}
+	return fmt.Sprintf("BreakerState(%v)", int(s))
+}
+
+// BreakerConfig - circuit breaker configuration, zero values use the defaults
+type BreakerConfig struct {
+	Name             string
+	FailureThreshold int           // Consecutive failures that open the breaker, default 5
+	OpenTimeout      time.Duration // Time in the open state before trial requests, default 30s
+	HalfOpenRequests int           // Concurrent trial requests in the half-open state, default 1
+	Failure          func(resp *http.Response, err error) bool
+	Clock            Clock
+}
+
+// Breaker - circuit breaker, a Chainable[core.Exchange]. Open breaker requests receive a 503 response and a
+// *core.Status with StatusUnavailable.
+type Breaker struct {
+	mu       sync.Mutex
+	cfg      BreakerConfig
+	state    BreakerState
+	failures int
+	trials   int
+	opened   time.Time
+}
+
+// NewBreaker - create a circuit breaker
+func NewBreaker(cfg BreakerConfig) *Breaker {
+	if cfg.FailureThreshold <= 0 {
+		cfg.FailureThreshold = 5
+	}
+	if cfg.OpenTimeout <= 0 {
+		cfg.OpenTimeout = time.Second * 30
+	}
+	if cfg.HalfOpenRequests <= 0 {
+		cfg.HalfOpenRequests = 1
+	}
+	if cfg.Failure == nil {
+		cfg.Failure = failure
+	}
+	cfg.Clock = clockOrSystem(cfg.Clock)
+	b := new(Breaker)
+	b.cfg = cfg
+	return b
+}
+
+// Name - network link name
+func (b *Breaker) Name() string {
+	if b.cfg.Name != "" {
+		return b.cfg.Name
+	}
+	return "breaker"
+}
+
+// State - current state, an open breaker is half-open once the open timeout has elapsed
+func (b *Breaker) State() BreakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.update()
+	return b.state
+}
+
+// Link - link the breaker
+func (b *Breaker) Link(next core.Exchange) core.Exchange {
+	if next == nil {
+		return nextNil("breaker")
+	}
+	return func(r *http.Request) (*http.Response, error) {
+		state, ok := b.allow()
+		if !ok {
+			return &http.Response{StatusCode: http.StatusServiceUnavailable}, core.NewStatus(core.StatusUnavailable, errors.New(fmt.Sprintf("circuit breaker is %v: %v", state, b.Name())))
+		}
+		// A panic is recorded as a failure, so a half-open trial is always released
+		failed := true
+		defer func() { b.record(state, failed) }()
+		resp, err := next(r)
+		failed = b.cfg.Failure(resp, err)
+		return resp, err
+	}
+}
+
+func (b *Breaker) allow() (BreakerState, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.update()
+	switch b.state {
+	case BreakerOpen:
+		return b.state, false
+	case BreakerHalfOpen:
+		if b.trials >= b.cfg.HalfOpenRequests {
+			return b.state, false
+		}
+		b.trials++
+	}
+	return b.state, true
+}
+
+// record - a half-open trial success closes the breaker, and a trial failure opens it
+func (b *Breaker) record(state BreakerState, failed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if state == BreakerHalfOpen {
+		if b.state != BreakerHalfOpen {
+			return
+		}
+		if failed {
+			b.open()
+		} else {
+			b.state = BreakerClosed
+			b.failures = 0
+		}
+		return
+	}
+	if b.state != BreakerClosed {
+		return
+	}
+	if !failed {
+		b.failures = 0
+		return
+	}
+	b.failures++
+	if b.failures >= b.cfg.FailureThreshold {
+		b.open()
+	}
+}
+
+func (b *Breaker) open() {
+	b.state = BreakerOpen
+	b.opened = b.cfg.Clock.Now()
+	b.failures = 0
+}
+
+func (b *Breaker) update() {
+	if b.state == BreakerOpen && b.cfg.Clock.Now().Sub(b.opened) >= b.cfg.OpenTimeout {
+		b.state = BreakerHalfOpen
+		b.trials = 0
+	}
+}
@@ -0,0 +1,85 @@
+package resilience
+
+import (
+	"fmt"
+	"github.com/appellative-ai/common/core"
+	"net/http"
+	"time"
+)
+
+func ExampleNewBreaker() {
+	code := http.StatusInternalServerError
+	server := func(next core.Exchange) core.Exchange {
+		return func(r *http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: code}, nil
+		}
+	}
+	clock := newTestClock()
+	b := NewBreaker(BreakerConfig{FailureThreshold: 2, OpenTimeout: time.Second * 10, Clock: clock})
+	ex := core.BuildNetwork([]any{b, server})
+	req, _ := http.NewRequest(http.MethodGet, "https://localhost/search", nil)
+
+	for i := 0; i < 3; i++ {
+		resp, err := ex(req)
+		fmt.Printf("test: Exchange() -> [status:%v] [state:%v] [err:%v]\n", resp.StatusCode, b.State(), err)
+	}
+
+	clock.Advance(time.Second * 10)
+	fmt.Printf("test: State() -> %v\n", b.State())
+	resp, _ := ex(req)
+	fmt.Printf("test: Exchange() -> [status:%v] [state:%v]\n", resp.StatusCode, b.State())
+
+	clock.Advance(time.Second * 10)
+	code = http.StatusOK
+	resp, _ = ex(req)
+	fmt.Printf("test: Exchange() -> [status:%v] [state:%v]\n", resp.StatusCode, b.State())
+
+	//Output:
+	//test: Exchange() -> [status:500] [state:closed] [err:<nil>]
+	//test: Exchange() -> [status:500] [state:open] [err:<nil>]
+	//test: Exchange() -> [status:503] [state:open] [err:Unavailable - circuit breaker is open: breaker]
+	//test: State() -> half-open
+	//test: Exchange() -> [status:500] [state:open]
+	//test: Exchange() -> [status:200] [state:closed]
+
+}
+
+func ExampleNewBreaker_panic() {
+	clock := newTestClock()
+	b := NewBreaker(BreakerConfig{FailureThreshold: 1, OpenTimeout: time.Second * 10, Clock: clock})
+	fail := true
+	server := func(next core.Exchange) core.Exchange {
+		return func(r *http.Request) (*http.Response, error) {
+			if fail {
+				panic("trial failure")
+			}
+			return &http.Response{StatusCode: http.StatusOK}, nil
+		}
+	}
+	ex := core.BuildNetwork([]any{b, server})
+	req, _ := http.NewRequest(http.MethodGet, "https://localhost/search", nil)
+	exchange := func() (resp *http.Response, v any) {
+		defer func() { v = recover() }()
+		resp, _ = ex(req)
+		return resp, nil
+	}
+
+	_, v := exchange()
+	fmt.Printf("test: Exchange() -> [panic:%v] [state:%v]\n", v, b.State())
+
+	// A half-open trial that panics is a failure, and the breaker is opened again
+	clock.Advance(time.Second * 10)
+	_, v = exchange()
+	fmt.Printf("test: Exchange() -> [panic:%v] [state:%v]\n", v, b.State())
+
+	clock.Advance(time.Second * 10)
+	fail = false
+	resp, _ := exchange()
+	fmt.Printf("test: Exchange() -> [status:%v] [state:%v]\n", resp.StatusCode, b.State())
+
+	//Output:
+	//test: Exchange() -> [panic:trial failure] [state:open]
+	//test: Exchange() -> [panic:trial failure] [state:open]
+	//test: Exchange() -> [status:200] [state:closed]
+
+}
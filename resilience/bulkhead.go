@@ -0,0 +1,77 @@
+package resilience
+
+import (
+	"errors"
+	"fmt"
+	"github.com/appellative-ai/common/core"
+	"net/http"
+	"time"
+)
+
+// Bulkhead - concurrency limit, a Chainable[core.Exchange]. Rejected requests receive a 429 response and a
+// *core.Status with StatusResourceExhausted. A slot is released when the next exchange returns.
+type Bulkhead struct {
+	name  string
+	slots chan struct{}
+	wait  time.Duration
+	clock Clock
+}
+
+// NewBulkhead - create a bulkhead with a maximum number of concurrent requests, and a maximum wait for a slot.
+// A zero wait rejects requests immediately when all slots are in use.
+func NewBulkhead(name string, limit int, wait time.Duration, clock Clock) (*Bulkhead, error) {
+	if limit <= 0 {
+		return nil, errors.New(fmt.Sprintf("bulkhead limit is invalid: %v", limit))
+	}
+	b := new(Bulkhead)
+	b.name = name
+	b.slots = make(chan struct{}, limit)
+	b.wait = wait
+	b.clock = clockOrSystem(clock)
+	return b, nil
+}
+
+// Name - network link name
+func (b *Bulkhead) Name() string {
+	if b.name != "" {
+		return b.name
+	}
+	return "bulkhead"
+}
+
+// InUse - number of slots in use
+func (b *Bulkhead) InUse() int {
+	return len(b.slots)
+}
+
+// Link - link the bulkhead
+func (b *Bulkhead) Link(next core.Exchange) core.Exchange {
+	if next == nil {
+		return nextNil("bulkhead")
+	}
+	return func(r *http.Request) (*http.Response, error) {
+		if err := b.acquire(r); err != nil {
+			return &http.Response{StatusCode: http.StatusTooManyRequests}, err
+		}
+		defer func() { <-b.slots }()
+		return next(r)
+	}
+}
+
+func (b *Bulkhead) acquire(r *http.Request) error {
+	select {
+	case b.slots <- struct{}{}:
+		return nil
+	default:
+	}
+	if b.wait > 0 {
+		select {
+		case b.slots <- struct{}{}:
+			return nil
+		case <-r.Context().Done():
+			return core.NewStatus(core.StatusCancelled, r.Context().Err())
+		case <-b.clock.After(b.wait):
+		}
+	}
+	return core.NewStatus(core.StatusResourceExhausted, errors.New(fmt.Sprintf("bulkhead limit of %v is exceeded: %v", cap(b.slots), b.Name())))
+}
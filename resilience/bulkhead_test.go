@@ -0,0 +1,45 @@
+package resilience
+
+import (
+	"fmt"
+	"github.com/appellative-ai/common/core"
+	"net/http"
+)
+
+func ExampleNewBulkhead() {
+	entered := make(chan struct{})
+	release := make(chan struct{})
+	server := func(next core.Exchange) core.Exchange {
+		return func(r *http.Request) (*http.Response, error) {
+			entered <- struct{}{}
+			<-release
+			return &http.Response{StatusCode: http.StatusOK}, nil
+		}
+	}
+	clock := newTestClock()
+	b, _ := NewBulkhead("search", 1, 0, clock)
+	ex := core.BuildNetwork([]any{b, server})
+	req, _ := http.NewRequest(http.MethodGet, "https://localhost/search", nil)
+
+	done := make(chan *http.Response)
+	go func() {
+		resp, _ := ex(req)
+		done <- resp
+	}()
+	<-entered
+	resp, err := ex(req)
+	fmt.Printf("test: Exchange() -> [status:%v] [in-use:%v] [err:%v]\n", resp.StatusCode, b.InUse(), err)
+
+	close(release)
+	resp = <-done
+	fmt.Printf("test: Exchange() -> [status:%v] [in-use:%v]\n", resp.StatusCode, b.InUse())
+
+	_, err = NewBulkhead("", 0, 0, nil)
+	fmt.Printf("test: NewBulkhead() -> [err:%v]\n", err)
+
+	//Output:
+	//test: Exchange() -> [status:429] [in-use:1] [err:Resource Exhausted - bulkhead limit of 1 is exceeded: search]
+	//test: Exchange() -> [status:200] [in-use:0]
+	//test: NewBulkhead() -> [err:bulkhead limit is invalid: 0]
+
+}
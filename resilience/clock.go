@@ -0,0 +1,28 @@
+package resilience
+
+import (
+	"github.com/appellative-ai/common/core"
+	"time"
+)
+
+var (
+	SystemClock Clock = systemClock{}
+)
+
+// Clock - injectable time source with timers, used for backoff, open timeouts and bulkhead waits
+type Clock interface {
+	core.Clock
+	After(d time.Duration) <-chan time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time                         { return time.Now() }
+func (systemClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+func clockOrSystem(c Clock) Clock {
+	if c == nil {
+		return SystemClock
+	}
+	return c
+}
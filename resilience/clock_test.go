@@ -0,0 +1,84 @@
+package resilience
+
+import (
+	"sync"
+	"time"
+)
+
+// testClock - After advances the clock and fires immediately, and records the durations
+type testClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	sleeps []time.Duration
+}
+
+func newTestClock() *testClock {
+	return &testClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func (c *testClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *testClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+	c.sleeps = append(c.sleeps, d)
+	ch := make(chan time.Time, 1)
+	ch <- c.now
+	return ch
+}
+
+func (c *testClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// timerClock - After fires when the clock is advanced past the timer
+type timerClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	timers []timerClockTimer
+}
+
+type timerClockTimer struct {
+	at time.Time
+	ch chan time.Time
+}
+
+func newTimerClock() *timerClock {
+	return &timerClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func (c *timerClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *timerClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ch := make(chan time.Time, 1)
+	c.timers = append(c.timers, timerClockTimer{at: c.now.Add(d), ch: ch})
+	return ch
+}
+
+func (c *timerClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+	var timers []timerClockTimer
+	for _, t := range c.timers {
+		if t.at.After(c.now) {
+			timers = append(timers, t)
+		} else {
+			t.ch <- c.now
+		}
+	}
+	c.timers = timers
+}
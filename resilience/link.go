@@ -0,0 +1,25 @@
+package resilience
+
+import (
+	"errors"
+	"github.com/appellative-ai/common/core"
+	"net/http"
+)
+
+// nextNil - all links require a next exchange
+func nextNil(name string) core.Exchange {
+	return func(r *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusInternalServerError}, errors.New(name + " next exchange is nil")
+	}
+}
+
+// failure - the default failure classification, an error or a 5xx response
+func failure(resp *http.Response, err error) bool {
+	return err != nil || resp == nil || resp.StatusCode >= http.StatusInternalServerError
+}
+
+func closeBody(resp *http.Response) {
+	if resp != nil && resp.Body != nil {
+		resp.Body.Close()
+	}
+}
@@ -0,0 +1,191 @@
+package resilience
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/appellative-ai/common/core"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	RetryAfter     = "Retry-After"
+	IdempotencyKey = "Idempotency-Key"
+)
+
+var (
+	idempotentMethods = []string{http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete}
+)
+
+// RetryConfig - retry configuration, zero values use the defaults
+type RetryConfig struct {
+	MaxAttempts int           // Total attempts, including the first, default 3
+	BaseDelay   time.Duration // Delay before the first retry, doubled for each retry, default 100ms
+	MaxDelay    time.Duration // Maximum delay, a longer Retry-After is not retried, default 10s
+	Jitter      float64       // Fraction of the delay that is randomized, 0 to 1, default 0.2, a negative value is no jitter
+	Methods     []string      // Methods that are retried, default is the idempotent methods
+	Retryable   func(resp *http.Response, err error) bool
+	Clock       Clock
+	Rand        func() float64 // Jitter random source in [0.0,1.0), default math/rand/v2
+}
+
+// Retry - retry link with exponential backoff and jitter. Only idempotent methods, or requests with an
+// Idempotency-Key header, are retried, and a Retry-After response header has precedence over the backoff.
+// Each attempt is a clone of the request with a new body, so the caller's request is not changed.
+// Links are returned as func(next core.Exchange) core.Exchange, as core.BuildNetwork does not accept the
+// named core.ExchangeLink type.
+func Retry(cfg RetryConfig) func(next core.Exchange) core.Exchange {
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 3
+	}
+	if cfg.BaseDelay <= 0 {
+		cfg.BaseDelay = time.Millisecond * 100
+	}
+	if cfg.MaxDelay <= 0 {
+		cfg.MaxDelay = time.Second * 10
+	}
+	if cfg.Jitter == 0 {
+		cfg.Jitter = 0.2
+	}
+	if len(cfg.Methods) == 0 {
+		cfg.Methods = idempotentMethods
+	}
+	if cfg.Retryable == nil {
+		cfg.Retryable = retryable
+	}
+	if cfg.Rand == nil {
+		cfg.Rand = rand.Float64
+	}
+	cfg.Clock = clockOrSystem(cfg.Clock)
+	return func(next core.Exchange) core.Exchange {
+		if next == nil {
+			return nextNil("retry")
+		}
+		return func(r *http.Request) (resp *http.Response, err error) {
+			if !cfg.retryMethod(r) {
+				return next(r)
+			}
+			body, err := replayBody(r)
+			if err != nil {
+				return &http.Response{StatusCode: http.StatusInternalServerError}, err
+			}
+			for attempt := 1; ; attempt++ {
+				req := r
+				if body != nil {
+					req = r.Clone(r.Context())
+					req.Body = body()
+					req.GetBody = func() (io.ReadCloser, error) { return body(), nil }
+				}
+				resp, err = next(req)
+				if attempt >= cfg.MaxAttempts || !cfg.Retryable(resp, err) {
+					return resp, err
+				}
+				delay, ok := cfg.delay(attempt, resp)
+				if !ok {
+					return resp, err
+				}
+				select {
+				case <-r.Context().Done():
+					return resp, err
+				case <-cfg.Clock.After(delay):
+				}
+				closeBody(resp)
+			}
+		}
+	}
+}
+
+func (cfg RetryConfig) retryMethod(r *http.Request) bool {
+	if r.Header.Get(IdempotencyKey) != "" {
+		return true
+	}
+	for _, m := range cfg.Methods {
+		if r.Method == m {
+			return true
+		}
+	}
+	return false
+}
+
+// delay - backoff delay for an attempt, a Retry-After greater than the maximum delay is not retried
+func (cfg RetryConfig) delay(attempt int, resp *http.Response) (time.Duration, bool) {
+	d := cfg.BaseDelay << (attempt - 1)
+	if d > cfg.MaxDelay || d <= 0 {
+		d = cfg.MaxDelay
+	}
+	if cfg.Jitter > 0 {
+		d -= time.Duration(float64(d) * min(cfg.Jitter, 1) * cfg.Rand())
+	}
+	if resp != nil {
+		if after, ok := ParseRetryAfter(resp.Header.Get(RetryAfter), cfg.Clock.Now()); ok {
+			if after > cfg.MaxDelay {
+				return 0, false
+			}
+			d = max(d, after)
+		}
+	}
+	return d, true
+}
+
+// ParseRetryAfter - parse a Retry-After header, either delay seconds or an HTTP date
+func ParseRetryAfter(s string, now time.Time) (time.Duration, bool) {
+	if s == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(s); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	t, err := http.ParseTime(s)
+	if err != nil {
+		return 0, false
+	}
+	return max(t.Sub(now), 0), true
+}
+
+// retryable - errors, except for a cancelled context, and 429, 502, 503 and 504 responses are retried
+func retryable(resp *http.Response, err error) bool {
+	if err != nil {
+		var status *core.Status
+		if errors.Is(err, context.Canceled) || errors.As(err, &status) && status.Code == core.StatusCancelled {
+			return false
+		}
+		return true
+	}
+	if resp == nil {
+		return true
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// replayBody - a request body is read once, if there is no GetBody, so that it can be replayed for each attempt
+func replayBody(r *http.Request) (func() io.ReadCloser, error) {
+	if r.Body == nil || r.Body == http.NoBody {
+		return nil, nil
+	}
+	if r.GetBody != nil {
+		return func() io.ReadCloser {
+			body, err := r.GetBody()
+			if err != nil {
+				return http.NoBody
+			}
+			return body
+		}, nil
+	}
+	buf, err := io.ReadAll(r.Body)
+	r.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	return func() io.ReadCloser { return io.NopCloser(bytes.NewReader(buf)) }, nil
+}
@@ -0,0 +1,123 @@
+package resilience
+
+import (
+	"fmt"
+	"github.com/appellative-ai/common/core"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+)
+
+func ExampleRetry() {
+	attempts := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		buf, _ := io.ReadAll(r.Body)
+		if attempts < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write(buf)
+	}))
+	defer ts.Close()
+
+	clock := newTestClock()
+	ex := core.BuildNetwork([]any{Retry(RetryConfig{MaxAttempts: 4, Clock: clock, Rand: func() float64 { return 0.5 }}), client})
+
+	req, _ := http.NewRequest(http.MethodPut, ts.URL, strings.NewReader("content"))
+	resp, err := ex(req)
+	buf, _ := io.ReadAll(resp.Body)
+	fmt.Printf("test: Retry(PUT) -> [status:%v] [body:%v] [attempts:%v] [sleeps:%v] [err:%v]\n", resp.StatusCode, string(buf), attempts, clock.sleeps, err)
+	buf, _ = io.ReadAll(req.Body)
+	fmt.Printf("test: Retry(PUT) -> [request-body:%v]\n", string(buf))
+
+	attempts = 0
+	req, _ = http.NewRequest(http.MethodPost, ts.URL, strings.NewReader("content"))
+	resp, err = ex(req)
+	fmt.Printf("test: Retry(POST) -> [status:%v] [attempts:%v] [err:%v]\n", resp.StatusCode, attempts, err)
+
+	attempts = 0
+	req, _ = http.NewRequest(http.MethodPost, ts.URL, strings.NewReader("content"))
+	req.Header.Set(IdempotencyKey, "key-1")
+	resp, err = ex(req)
+	fmt.Printf("test: Retry(POST Idempotency-Key) -> [status:%v] [attempts:%v] [err:%v]\n", resp.StatusCode, attempts, err)
+
+	//Output:
+	//test: Retry(PUT) -> [status:200] [body:content] [attempts:3] [sleeps:[90ms 180ms]] [err:<nil>]
+	//test: Retry(PUT) -> [request-body:content]
+	//test: Retry(POST) -> [status:503] [attempts:1] [err:<nil>]
+	//test: Retry(POST Idempotency-Key) -> [status:200] [attempts:3] [err:<nil>]
+
+}
+
+func ExampleRetry_retryAfter() {
+	attempts := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		switch attempts {
+		case 1:
+			w.Header().Set(RetryAfter, "2")
+			w.WriteHeader(http.StatusTooManyRequests)
+		case 2:
+			w.Header().Set(RetryAfter, "60")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}
+	}))
+	defer ts.Close()
+
+	clock := newTestClock()
+	ex := core.BuildNetwork([]any{Retry(RetryConfig{MaxAttempts: 5, Jitter: 0.5, Rand: func() float64 { return 0.5 }, Clock: clock}), client})
+	req, _ := http.NewRequest(http.MethodGet, ts.URL, nil)
+	resp, err := ex(req)
+	fmt.Printf("test: Retry() -> [status:%v] [attempts:%v] [sleeps:%v] [err:%v]\n", resp.StatusCode, attempts, clock.sleeps, err)
+
+	d, ok := ParseRetryAfter("Mon, 01 Jan 2024 00:00:30 GMT", clock.Now())
+	fmt.Printf("test: ParseRetryAfter() -> %v [ok:%v]\n", d, ok)
+
+	//Output:
+	//test: Retry() -> [status:429] [attempts:2] [sleeps:[2s]] [err:<nil>]
+	//test: ParseRetryAfter() -> 28s [ok:true]
+
+}
+
+func ExampleRetry_jitter() {
+	cfg := RetryConfig{BaseDelay: time.Second, MaxDelay: time.Second * 5, Jitter: 0.5, Rand: func() float64 { return 0.5 }, Clock: newTestClock()}
+	for attempt := 1; attempt <= 4; attempt++ {
+		d, _ := cfg.delay(attempt, nil)
+		fmt.Printf("test: delay(%v) -> %v\n", attempt, d)
+	}
+
+	//Output:
+	//test: delay(1) -> 750ms
+	//test: delay(2) -> 1.5s
+	//test: delay(3) -> 3s
+	//test: delay(4) -> 3.75s
+
+}
+
+func ExampleRetry_noJitter() {
+	clock := newTestClock()
+	attempts := 0
+	server := func(next core.Exchange) core.Exchange {
+		return func(r *http.Request) (*http.Response, error) {
+			attempts++
+			return &http.Response{StatusCode: http.StatusServiceUnavailable}, nil
+		}
+	}
+	ex := core.BuildNetwork([]any{Retry(RetryConfig{Jitter: -1, Clock: clock}), server})
+	req, _ := http.NewRequest(http.MethodGet, "https://localhost/search", nil)
+	resp, _ := ex(req)
+	fmt.Printf("test: Retry() -> [status:%v] [attempts:%v] [sleeps:%v]\n", resp.StatusCode, attempts, clock.sleeps)
+
+	//Output:
+	//test: Retry() -> [status:503] [attempts:3] [sleeps:[100ms 200ms]]
+
+}
+
+func client(next core.Exchange) core.Exchange {
+	return func(r *http.Request) (*http.Response, error) {
+		return http.DefaultClient.Do(r)
+	}
+}
@@ -0,0 +1,98 @@
+package resilience
+
+import (
+	"context"
+	"errors"
+	"github.com/appellative-ai/common/core"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Timeout - per link timeout, a deadline exceeded error is returned as a *core.Status with
+// StatusDeadlineExceeded and a 504 response. A zero timeout does not bound the request, and a nil clock is
+// the SystemClock.
+func Timeout(timeout time.Duration, clock Clock) func(next core.Exchange) core.Exchange {
+	clock = clockOrSystem(clock)
+	return func(next core.Exchange) core.Exchange {
+		if next == nil {
+			return nextNil("timeout")
+		}
+		return func(r *http.Request) (*http.Response, error) {
+			ctx, cancel := newTimeoutContext(r.Context(), timeout, clock)
+			resp, err := next(r.WithContext(ctx))
+			if errors.Is(err, context.DeadlineExceeded) || err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				cancel()
+				closeBody(resp)
+				return &http.Response{StatusCode: http.StatusGatewayTimeout}, core.NewStatus(core.StatusDeadlineExceeded, err)
+			}
+			// The context is cancelled when the body is closed, so the body can still be read
+			if resp != nil && resp.Body != nil {
+				resp.Body = &cancelBody{ReadCloser: resp.Body, cancel: cancel}
+			} else {
+				cancel()
+			}
+			return resp, err
+		}
+	}
+}
+
+// newTimeoutContext - a context that is cancelled by a clock timer, with a deadline from the clock
+func newTimeoutContext(ctx context.Context, timeout time.Duration, clock Clock) (context.Context, func()) {
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+	c, cancel := context.WithCancelCause(ctx)
+	stop := make(chan struct{})
+	// The timer is started before returning, so the clock can be advanced by the caller
+	timer := clock.After(timeout)
+	go func() {
+		select {
+		case <-timer:
+			cancel(context.DeadlineExceeded)
+		case <-stop:
+		case <-c.Done():
+		}
+	}()
+	var once sync.Once
+	return &clockContext{Context: c, deadline: clock.Now().Add(timeout)}, func() {
+		once.Do(func() {
+			close(stop)
+			cancel(context.Canceled)
+		})
+	}
+}
+
+// clockContext - a context with a deadline from a Clock
+type clockContext struct {
+	context.Context
+	deadline time.Time
+}
+
+func (c *clockContext) Deadline() (time.Time, bool) {
+	if d, ok := c.Context.Deadline(); ok && d.Before(c.deadline) {
+		return d, true
+	}
+	return c.deadline, true
+}
+
+// Err - context.DeadlineExceeded if the clock timer fired
+func (c *clockContext) Err() error {
+	err := c.Context.Err()
+	if err != nil && errors.Is(context.Cause(c.Context), context.DeadlineExceeded) {
+		return context.DeadlineExceeded
+	}
+	return err
+}
+
+type cancelBody struct {
+	io.ReadCloser
+	cancel func()
+}
+
+func (b *cancelBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
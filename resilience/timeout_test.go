@@ -0,0 +1,56 @@
+package resilience
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/appellative-ai/common/core"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+func ExampleTimeout() {
+	clock := newTimerClock()
+	upstream := func(next core.Exchange) core.Exchange {
+		return func(r *http.Request) (*http.Response, error) {
+			if r.URL.Path == "/slow" {
+				clock.Advance(time.Millisecond * 100)
+				<-r.Context().Done()
+				return nil, r.Context().Err()
+			}
+			return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("content"))}, nil
+		}
+	}
+
+	ex := core.BuildNetwork([]any{Timeout(time.Millisecond*100, clock), upstream})
+	req, _ := http.NewRequest(http.MethodGet, "https://localhost/fast", nil)
+	resp, err := ex(req)
+	buf, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	fmt.Printf("test: Timeout(\"/fast\") -> [status:%v] [body:%v] [err:%v]\n", resp.StatusCode, string(buf), err)
+
+	req, _ = http.NewRequest(http.MethodGet, "https://localhost/slow", nil)
+	resp, err = ex(req)
+	fmt.Printf("test: Timeout(\"/slow\") -> [status:%v] [deadline:%v]\n", resp.StatusCode, errors.Is(err, core.NewStatus(core.StatusDeadlineExceeded, nil)))
+
+	// The SystemClock uses the same clock timer context
+	system := func(next core.Exchange) core.Exchange {
+		return func(r *http.Request) (*http.Response, error) {
+			_, ok := r.Context().Deadline()
+			<-r.Context().Done()
+			fmt.Printf("test: SystemClock -> [deadline:%v] [err:%v]\n", ok, r.Context().Err())
+			return nil, r.Context().Err()
+		}
+	}
+	resp, err = core.BuildNetwork([]any{Timeout(time.Millisecond*10, nil), system})(req)
+	fmt.Printf("test: Timeout(\"/slow\") -> [status:%v] [deadline:%v]\n", resp.StatusCode, errors.Is(err, context.DeadlineExceeded))
+
+	//Output:
+	//test: Timeout("/fast") -> [status:200] [body:content] [err:<nil>]
+	//test: Timeout("/slow") -> [status:504] [deadline:true]
+	//test: SystemClock -> [deadline:true] [err:context deadline exceeded]
+	//test: Timeout("/slow") -> [status:504] [deadline:true]
+
+}